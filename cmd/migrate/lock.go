@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq"
+)
+
+// migrationLockKey is the advisory-lock key shared by every migrate
+// process targeting the same database. The value is arbitrary but must be
+// stable across releases.
+const migrationLockKey = 0x454d504c4f593031 // "EMPLOY01"
+
+// migrationLock holds a Postgres session-level advisory lock for the
+// duration of a migration run so concurrent runs cannot interleave.
+type migrationLock struct {
+	db *sql.DB
+}
+
+// acquireMigrationLock opens a dedicated connection and takes the advisory
+// lock, polling until it is granted or the timeout elapses.
+func acquireMigrationLock(databaseURL string, timeout time.Duration) (*migrationLock, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock connection: %w", err)
+	}
+	// The advisory lock is session-scoped, so the lock must live on a
+	// single connection for its whole lifetime.
+	db.SetMaxOpenConns(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	for {
+		var acquired bool
+		if err := db.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, migrationLockKey).Scan(&acquired); err != nil {
+			db.Close()
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("could not acquire migration lock within %s: another migration is likely running", timeout)
+			}
+			return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired {
+			return &migrationLock{db: db}, nil
+		}
+		select {
+		case <-ctx.Done():
+			db.Close()
+			return nil, fmt.Errorf("could not acquire migration lock within %s: another migration is likely running", timeout)
+		case <-time.After(250 * time.Millisecond):
+		}
+	}
+}
+
+// release frees the advisory lock and closes its connection.
+func (l *migrationLock) release() {
+	_, err := l.db.Exec(`SELECT pg_advisory_unlock($1)`, migrationLockKey)
+	if err != nil {
+		fmt.Printf("warning: failed to release migration lock: %v\n", err)
+	}
+	l.db.Close()
+}
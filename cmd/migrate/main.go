@@ -0,0 +1,268 @@
+// Command migrate manages the database schema for the employee management
+// service.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/spf13/cobra"
+
+	"github.com/captain-corgi/vcd-claude-speckit/migrations"
+)
+
+var (
+	databaseURL     string
+	lockTimeout     time.Duration
+	migrationsDir   string
+	migrationSource string
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Database migration tool for the employee management service",
+	}
+	rootCmd.PersistentFlags().StringVar(&databaseURL, "database-url", os.Getenv("DATABASE_URL"),
+		"Postgres connection string (defaults to DATABASE_URL)")
+	rootCmd.PersistentFlags().DurationVar(&lockTimeout, "lock-timeout", 30*time.Second,
+		"how long to wait for the migration advisory lock")
+	rootCmd.PersistentFlags().StringVar(&migrationsDir, "migrations-dir", "migrations",
+		"directory containing the SQL migrations (file source only)")
+	rootCmd.PersistentFlags().StringVar(&migrationSource, "source", "file",
+		"where to read migrations from: embedded or file")
+
+	rootCmd.AddCommand(upCmd(), downCmd(), versionCmd(), forceCmd(), repairCmd(), createCmd(), seedCmd(), repairManagersCmd())
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// createMigrateInstance builds a migrate.Migrate reading migrations from
+// the selected source: the compiled-in go:embed filesystem, or the
+// configured migrations directory resolved to an absolute path.
+func createMigrateInstance() (*migrate.Migrate, error) {
+	if databaseURL == "" {
+		return nil, fmt.Errorf("database URL is required (--database-url or DATABASE_URL)")
+	}
+	switch migrationSource {
+	case "embedded":
+		source, err := iofs.New(migrations.FS, ".")
+		if err != nil {
+			return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+		}
+		m, err := migrate.NewWithSourceInstance("iofs", source, databaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create migrate instance: %w", err)
+		}
+		return m, nil
+	case "file":
+		dir, err := resolveMigrationsDir()
+		if err != nil {
+			return nil, err
+		}
+		m, err := migrate.New("file://"+dir, databaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create migrate instance: %w", err)
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unknown migration source %q (expected embedded or file)", migrationSource)
+	}
+}
+
+// resolveMigrationsDir expands the --migrations-dir flag to an absolute
+// path and verifies it exists.
+func resolveMigrationsDir() (string, error) {
+	dir, err := filepath.Abs(migrationsDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve migrations directory: %w", err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return "", fmt.Errorf("migrations directory %s is not accessible: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("migrations path %s is not a directory", dir)
+	}
+	return dir, nil
+}
+
+func upCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := createMigrateInstance()
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+			lock, err := acquireMigrationLock(databaseURL, lockTimeout)
+			if err != nil {
+				return err
+			}
+			defer lock.release()
+			if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+				return fmt.Errorf("migration failed: %w", err)
+			}
+			fmt.Println("migrations applied")
+			return nil
+		},
+	}
+}
+
+func downCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the most recent migration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := createMigrateInstance()
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+			lock, err := acquireMigrationLock(databaseURL, lockTimeout)
+			if err != nil {
+				return err
+			}
+			defer lock.release()
+			if err := m.Steps(-1); err != nil && err != migrate.ErrNoChange {
+				return fmt.Errorf("rollback failed: %w", err)
+			}
+			fmt.Println("rolled back one migration")
+			return nil
+		},
+	}
+}
+
+func versionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the current migration version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := createMigrateInstance()
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+			version, dirty, err := m.Version()
+			if err == migrate.ErrNilVersion {
+				fmt.Println("no migrations applied")
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read version: %w", err)
+			}
+			fmt.Printf("version: %d dirty: %v\n", version, dirty)
+			return nil
+		},
+	}
+}
+
+func forceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "force <version>",
+		Short: "Force the migration version without running migrations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var version int
+			if _, err := fmt.Sscanf(args[0], "%d", &version); err != nil {
+				return fmt.Errorf("invalid version: %s", args[0])
+			}
+			m, err := createMigrateInstance()
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+			if err := m.Force(version); err != nil {
+				return fmt.Errorf("force failed: %w", err)
+			}
+			fmt.Printf("forced version to %d\n", version)
+			return nil
+		},
+	}
+}
+
+func createCmd() *cobra.Command {
+	var dir string
+	var noTemplate bool
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new pair of migration files",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dir == "" {
+				dir = migrationsDir
+			}
+			abs, err := filepath.Abs(dir)
+			if err != nil {
+				return fmt.Errorf("failed to resolve migrations directory: %w", err)
+			}
+			return createMigrationFile(args[0], abs, noTemplate)
+		},
+	}
+	cmd.Flags().StringVar(&dir, "dir", "", "directory to write the migration files into (defaults to --migrations-dir)")
+	cmd.Flags().BoolVar(&noTemplate, "no-template", false, "write empty migration files instead of SQL boilerplate")
+	return cmd
+}
+
+// createMigrationFile writes timestamped up/down migration files into dir,
+// refusing to create a migration whose version or name collides with an
+// existing pair.
+func createMigrationFile(name, dir string, noTemplate bool) error {
+	version := time.Now().Format("20060102150405")
+	if err := checkMigrationCollision(dir, version, name); err != nil {
+		return err
+	}
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", version, name, direction))
+		content := ""
+		if !noTemplate {
+			content = fmt.Sprintf("-- Migration: %s (%s)\n-- Add your SQL here.\n", name, direction)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Printf("created %s\n", path)
+	}
+	return nil
+}
+
+// checkMigrationCollision rejects a new migration whose version or name
+// matches an existing file in dir, so duplicate names cannot slip in.
+func checkMigrationCollision(dir, version, name string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("migrations directory %s does not exist", dir)
+		}
+		return fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+	for _, entry := range entries {
+		base := entry.Name()
+		if !strings.HasSuffix(base, ".sql") {
+			continue
+		}
+		existingVersion, rest, ok := strings.Cut(base, "_")
+		if !ok {
+			continue
+		}
+		existingName := strings.TrimSuffix(strings.TrimSuffix(rest, ".sql"), ".up")
+		existingName = strings.TrimSuffix(existingName, ".down")
+		if existingVersion == version {
+			return fmt.Errorf("migration version %s already exists (%s)", version, base)
+		}
+		if existingName == name {
+			return fmt.Errorf("migration named %q already exists (%s)", name, base)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/postgres"
+)
+
+func repairManagersCmd() *cobra.Command {
+	var strategy string
+	var newManagerID string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "repair-managers",
+		Short: "Find and fix employees whose manager reference no longer resolves",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if databaseURL == "" {
+				return fmt.Errorf("database URL is required (--database-url or DATABASE_URL)")
+			}
+			db, err := postgres.Open(databaseURL)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			service := domain.NewEmployeeService(
+				postgres.NewEmployeeRepository(db),
+				postgres.NewAuditLogRepository(db),
+				postgres.NewEventStore(db),
+				domain.NewEventDispatcher(),
+			)
+			ctx := context.Background()
+
+			orphaned, err := service.FindOrphanedManagerReferences(ctx)
+			if err != nil {
+				return err
+			}
+			for _, e := range orphaned {
+				fmt.Printf("orphaned: %s (%s) -> missing manager %s\n", e.DisplayName(), e.ID, *e.ManagerID)
+			}
+			if len(orphaned) == 0 {
+				fmt.Println("no orphaned manager references found")
+				return nil
+			}
+			if dryRun {
+				fmt.Printf("%d orphaned references found (dry run, nothing changed)\n", len(orphaned))
+				return nil
+			}
+
+			var replacement *uuid.UUID
+			if newManagerID != "" {
+				id, err := uuid.Parse(newManagerID)
+				if err != nil {
+					return fmt.Errorf("invalid new manager id: %s", newManagerID)
+				}
+				replacement = &id
+			}
+			repaired, err := service.RepairManagerReferences(ctx,
+				domain.ManagerRepairStrategy(strategy), replacement)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("repaired %d employees\n", repaired)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&strategy, "strategy", string(domain.RepairStrategyNullify),
+		"how to fix dangling references: NULLIFY or REASSIGN")
+	cmd.Flags().StringVar(&newManagerID, "new-manager-id", "",
+		"replacement manager for the REASSIGN strategy")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"only report orphaned references without changing anything")
+	return cmd
+}
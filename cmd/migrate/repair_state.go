@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/spf13/cobra"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/postgres"
+	"github.com/captain-corgi/vcd-claude-speckit/migrations"
+)
+
+// coreTables must exist for the schema to be considered intact enough to
+// repair; their absence means the failure is deeper than a dirty flag.
+var coreTables = []string{"schema_migrations", "users", "employees", "audit_logs"}
+
+// repairCmd inspects dirty migration state and, only with explicit
+// confirmation, forces the version back to the last known-good one. Unlike
+// the blunt `force`, it derives the target from the migration source and
+// validates the schema first.
+func repairCmd() *cobra.Command {
+	var confirm bool
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Detect dirty migration state and restore the last known-good version",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := createMigrateInstance()
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			version, dirty, err := m.Version()
+			if err == migrate.ErrNilVersion {
+				fmt.Println("no migrations applied; nothing to repair")
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read version: %w", err)
+			}
+			if !dirty {
+				fmt.Printf("version %d is clean; nothing to repair\n", version)
+				return nil
+			}
+			fmt.Printf("migration %d failed mid-way and left the schema dirty\n", version)
+
+			previous, ok, err := previousVersion(uint64(version))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("no migration precedes version %d; restore the database from a backup instead", version)
+			}
+			fmt.Printf("last known-good version: %d\n", previous)
+
+			if err := validateCoreSchema(); err != nil {
+				return fmt.Errorf("schema validation failed, refusing to repair: %w", err)
+			}
+			if !confirm {
+				fmt.Printf("schema looks intact; re-run with --confirm to force the version back to %d\n", previous)
+				return nil
+			}
+
+			lock, err := acquireMigrationLock(databaseURL, lockTimeout)
+			if err != nil {
+				return err
+			}
+			defer lock.release()
+			if err := m.Force(int(previous)); err != nil {
+				return fmt.Errorf("repair failed: %w", err)
+			}
+			fmt.Printf("forced version to %d; fix migration %d and run 'up' to retry\n", previous, version)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&confirm, "confirm", false,
+		"actually force the version back after validation (default only reports)")
+	return cmd
+}
+
+// previousVersion returns the highest migration version below the given
+// one, read from the configured migration source.
+func previousVersion(version uint64) (uint64, bool, error) {
+	versions, err := migrationVersions()
+	if err != nil {
+		return 0, false, err
+	}
+	var previous uint64
+	var found bool
+	for _, v := range versions {
+		if v < version && (!found || v > previous) {
+			previous, found = v, true
+		}
+	}
+	return previous, found, nil
+}
+
+// migrationVersions lists the distinct migration versions available in the
+// configured source.
+func migrationVersions() ([]uint64, error) {
+	var names []string
+	switch migrationSource {
+	case "embedded":
+		entries, err := migrations.FS.ReadDir(".")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+		}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+	case "file":
+		dir, err := resolveMigrationsDir()
+		if err != nil {
+			return nil, err
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+		}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+	default:
+		return nil, fmt.Errorf("unknown migration source %q (expected embedded or file)", migrationSource)
+	}
+
+	seen := make(map[uint64]bool)
+	var versions []uint64
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+		raw, _, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		if !seen[version] {
+			seen[version] = true
+			versions = append(versions, version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// validateCoreSchema verifies the core tables still exist before the dirty
+// flag is cleared, so a half-destroyed schema is not silently marked good.
+func validateCoreSchema() error {
+	db, err := postgres.Open(databaseURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	for _, table := range coreTables {
+		var exists bool
+		err := db.QueryRow(
+			`SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)`,
+			table).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to check table %s: %w", table, err)
+		}
+		if !exists {
+			return fmt.Errorf("core table %s is missing", table)
+		}
+	}
+	return nil
+}
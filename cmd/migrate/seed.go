@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/postgres"
+)
+
+// seedEmployee describes one sample employee inserted by the seed command.
+type seedEmployee struct {
+	firstName  string
+	lastName   string
+	email      string
+	department string
+	position   string
+	salary     float64
+	hasManager bool
+}
+
+// sampleEmployees are the development fixtures inserted by `migrate seed`.
+// The first entry is the manager for the ones flagged hasManager.
+var sampleEmployees = []seedEmployee{
+	{"Alice", "Nguyen", "alice.nguyen@example.com", "Engineering", "Engineering Manager", 140000, false},
+	{"Bob", "Tran", "bob.tran@example.com", "Engineering", "Software Engineer", 95000, true},
+	{"Carol", "Pham", "carol.pham@example.com", "Engineering", "Software Engineer", 98000, true},
+	{"David", "Le", "david.le@example.com", "Sales", "Account Executive", 80000, false},
+	{"Erin", "Hoang", "erin.hoang@example.com", "Human Resources", "HR Specialist", 72000, false},
+}
+
+func seedCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed",
+		Short: "Insert a default admin user and sample employees into a fresh database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if databaseURL == "" {
+				return fmt.Errorf("database URL is required (--database-url or DATABASE_URL)")
+			}
+			db, err := postgres.Open(databaseURL)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ctx := context.Background()
+			if err := seedAdminUser(ctx, postgres.NewUserRepository(db)); err != nil {
+				return err
+			}
+			return seedSampleEmployees(ctx, postgres.NewEmployeeRepository(db))
+		},
+	}
+}
+
+// seedAdminUser creates the default admin account with a generated password
+// printed exactly once. It is a no-op if the account already exists.
+func seedAdminUser(ctx context.Context, users *postgres.UserRepository) error {
+	const username = "admin"
+	exists, err := users.ExistsByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+	if exists {
+		fmt.Println("admin user already exists, skipping")
+		return nil
+	}
+
+	password, err := generatePassword()
+	if err != nil {
+		return fmt.Errorf("failed to generate admin password: %w", err)
+	}
+	admin, err := domain.NewUser(username, "admin@example.com", password, domain.RoleAdmin)
+	if err != nil {
+		return fmt.Errorf("failed to build admin user: %w", err)
+	}
+	if err := users.Create(ctx, admin); err != nil {
+		return fmt.Errorf("failed to insert admin user: %w", err)
+	}
+	fmt.Printf("created admin user %q with password: %s\n", username, password)
+	fmt.Println("store this password now; it will not be shown again")
+	return nil
+}
+
+// seedSampleEmployees inserts the sample employees, skipping any whose
+// email is already present so the command stays idempotent.
+func seedSampleEmployees(ctx context.Context, employees *postgres.EmployeeRepository) error {
+	hireDate := time.Now().AddDate(-1, 0, 0)
+	var managerID *uuid.UUID
+	for _, sample := range sampleEmployees {
+		exists, err := employees.ExistsByEmail(ctx, sample.email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			fmt.Printf("employee %s already exists, skipping\n", sample.email)
+			continue
+		}
+
+		var manager *uuid.UUID
+		if sample.hasManager {
+			manager = managerID
+		}
+		employee, err := domain.NewEmployee(sample.firstName, sample.lastName, sample.email,
+			"", sample.department, sample.position, sample.salary, hireDate, manager)
+		if err != nil {
+			return fmt.Errorf("failed to build employee %s: %w", sample.email, err)
+		}
+		if err := employees.Create(ctx, employee); err != nil {
+			return fmt.Errorf("failed to insert employee %s: %w", sample.email, err)
+		}
+		if managerID == nil {
+			managerID = &employee.ID
+		}
+		fmt.Printf("created employee %s\n", sample.email)
+	}
+	return nil
+}
+
+// passwordClasses ensure the generated password satisfies every character
+// class the password policy requires.
+var passwordClasses = []string{
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"abcdefghijkmnopqrstuvwxyz",
+	"23456789",
+	"!@#$%^&*-_",
+}
+
+// generatePassword produces a 20-character random password containing at
+// least one character from each required class.
+func generatePassword() (string, error) {
+	const length = 20
+	all := ""
+	for _, class := range passwordClasses {
+		all += class
+	}
+
+	chars := make([]byte, 0, length)
+	for _, class := range passwordClasses {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		chars = append(chars, c)
+	}
+	for len(chars) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		chars = append(chars, c)
+	}
+	// Shuffle so the guaranteed class characters are not predictably placed.
+	for i := len(chars) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		chars[i], chars[j.Int64()] = chars[j.Int64()], chars[i]
+	}
+	return string(chars), nil
+}
+
+func randomChar(alphabet string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+	if err != nil {
+		return 0, err
+	}
+	return alphabet[n.Int64()], nil
+}
@@ -0,0 +1,163 @@
+// Command server runs the employee management GraphQL API.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/config"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/graphql"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/lifecycle"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/postgres"
+)
+
+// shutdownTimeout bounds how long shutdown waits for in-flight work.
+const shutdownTimeout = 30 * time.Second
+
+// newTokenService builds the token service for the configured signing
+// algorithm: HS256 with the shared secret, or RS256/ES256 with a private
+// key loaded from disk.
+func newTokenService(cfg *config.Config) (*auth.TokenService, error) {
+	if cfg.JWTAlgorithm == "HS256" {
+		return auth.NewTokenService(cfg.JWTSecret), nil
+	}
+	if cfg.JWTPrivateKeyFile == "" {
+		return nil, fmt.Errorf("JWT_PRIVATE_KEY_FILE is required for %s", cfg.JWTAlgorithm)
+	}
+	pem, err := os.ReadFile(cfg.JWTPrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+	return auth.NewTokenServiceWithKey(cfg.JWTAlgorithm, pem, cfg.JWTKeyID)
+}
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	db, err := postgres.Open(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if cfg.MaxSalary > 0 {
+		domain.SetSalaryBounds(&domain.SalaryBounds{MaxSalary: cfg.MaxSalary})
+	}
+
+	employeeRepo := postgres.NewEmployeeRepository(db)
+	userRepo := postgres.NewUserRepository(db)
+	auditRepo := postgres.NewAuditLogRepository(db)
+	eventStore := postgres.NewEventStore(db)
+	dispatcher := domain.NewEventDispatcher()
+
+	employeeService := domain.NewEmployeeService(employeeRepo, auditRepo, eventStore, dispatcher)
+	employeeService.SetPositionRepository(postgres.NewPositionRepository(db))
+	employeeService.SetEnforcePositionCatalog(cfg.EnforcePositionCatalog)
+	employeeService.SetUserRepository(userRepo)
+	employeeService.SetTransactor(postgres.NewTransactor(db))
+	userService := domain.NewUserService(userRepo, auditRepo, dispatcher)
+	userService.SetEmployeeRepository(employeeRepo)
+	tokenService, err := newTokenService(cfg)
+	if err != nil {
+		log.Fatalf("failed to configure token signing: %v", err)
+	}
+	if cfg.AccessTokenTTL > 0 {
+		tokenService.SetAccessTokenTTL(cfg.AccessTokenTTL)
+	}
+	if cfg.RefreshTokenTTL > 0 {
+		tokenService.SetRefreshTokenTTL(cfg.RefreshTokenTTL)
+	}
+	if cfg.ExtendedRefreshTokenTTL > 0 {
+		tokenService.SetExtendedRefreshTokenTTL(cfg.ExtendedRefreshTokenTTL)
+	}
+
+	corsConfig := graphql.DefaultCORSConfig()
+	corsConfig.AllowedOrigins = cfg.CORSAllowedOrigins
+
+	handlerOpts := []graphql.HandlerOption{graphql.WithCORS(corsConfig)}
+	if cfg.AuthCookie {
+		handlerOpts = append(handlerOpts, graphql.WithAuthCookie())
+	}
+	if cfg.IsProduction() {
+		handlerOpts = append(handlerOpts, graphql.WithErrorMasking())
+	}
+
+	resolver := graphql.NewResolver(employeeService, userService, tokenService)
+	handler, err := graphql.NewHandler(resolver, tokenService, handlerOpts...)
+	if err != nil {
+		log.Fatalf("failed to build schema: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", handler)
+	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(tokenService.JWKS())
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	var root http.Handler = mux
+	if cfg.SecurityHeaders {
+		securityConfig := graphql.DefaultSecurityHeadersConfig()
+		if cfg.IsProduction() {
+			securityConfig.HSTS = "max-age=31536000; includeSubDomains"
+		}
+		root = graphql.SecurityHeadersMiddleware(securityConfig, root)
+	}
+
+	server := &http.Server{Addr: ":" + cfg.Port, Handler: root}
+	life := lifecycle.New()
+	// Registered before the HTTP server so shutdown stops the server
+	// first, then drains whatever the last requests enqueued.
+	life.Add(lifecycle.RunnerFunc{
+		StartFunc: func(ctx context.Context) error {
+			dispatcher.StartAsync(domain.DefaultEventWorkers, domain.DefaultEventQueueSize)
+			return nil
+		},
+		StopFunc: dispatcher.Drain,
+	})
+	life.Add(lifecycle.RunnerFunc{
+		StartFunc: func(ctx context.Context) error {
+			go func() {
+				if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.Fatalf("server error: %v", err)
+				}
+			}()
+			return nil
+		},
+		StopFunc: server.Shutdown,
+	})
+
+	ctx := context.Background()
+	if err := life.Start(ctx); err != nil {
+		log.Fatalf("failed to start: %v", err)
+	}
+	log.Printf("listening on :%s", cfg.Port)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	log.Println("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	defer cancel()
+	if err := life.Stop(shutdownCtx); err != nil {
+		log.Printf("shutdown error: %v", err)
+	}
+}
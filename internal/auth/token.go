@@ -0,0 +1,317 @@
+// Package auth issues and validates the JWTs used by the GraphQL API.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// AccessTokenTTL is the default lifetime of an issued access token.
+const AccessTokenTTL = 15 * time.Minute
+
+// RefreshTokenTTL is the default lifetime of refresh credentials.
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+// ExtendedRefreshTokenTTL is the default refresh lifetime for sessions
+// opened with rememberMe.
+const ExtendedRefreshTokenTTL = 30 * 24 * time.Hour
+
+// TokenTypeRefresh is the typ claim carried by refresh tokens; access
+// tokens leave the claim empty.
+const TokenTypeRefresh = "refresh"
+
+// Clock supplies the current time to the token service so tests can
+// control issuance and expiry deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the default Clock, backed by time.Now.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// Claims are the JWT claims embedded in issued tokens.
+type Claims struct {
+	UserID   uuid.UUID       `json:"uid"`
+	Username string          `json:"username"`
+	Role     domain.UserRole `json:"role"`
+	// TokenType distinguishes refresh tokens from access tokens, so one
+	// can never be presented as the other.
+	TokenType string `json:"typ,omitempty"`
+	// Extended marks refresh tokens issued for rememberMe sessions, so
+	// policy can treat long-lived sessions differently.
+	Extended bool `json:"ext,omitempty"`
+	// TokenVersion is the user's token version at issuance. A password
+	// change bumps the user's version, making earlier tokens stale.
+	TokenVersion int `json:"tv"`
+	jwt.RegisteredClaims
+}
+
+// TokenService signs and verifies access tokens. It supports symmetric
+// HS256 as well as asymmetric RS256/ES256, where verifiers only need the
+// public key. Verification keys are selected by the token's kid header so
+// keys can be rotated without invalidating outstanding tokens.
+type TokenService struct {
+	mu      sync.RWMutex
+	method  jwt.SigningMethod
+	kid     string
+	signKey any
+	// verifyKeys maps kid to verification key. HS256 tokens carry no kid
+	// and verify under the "" entry.
+	verifyKeys map[string]any
+
+	clock              Clock
+	accessTTL          time.Duration
+	refreshTTL         time.Duration
+	extendedRefreshTTL time.Duration
+}
+
+// NewTokenService creates a TokenService signing with HS256 and the given
+// secret.
+func NewTokenService(secret string) *TokenService {
+	key := []byte(secret)
+	return &TokenService{
+		method:             jwt.SigningMethodHS256,
+		signKey:            key,
+		verifyKeys:         map[string]any{"": key},
+		clock:              systemClock{},
+		accessTTL:          AccessTokenTTL,
+		refreshTTL:         RefreshTokenTTL,
+		extendedRefreshTTL: ExtendedRefreshTokenTTL,
+	}
+}
+
+// NewTokenServiceWithKey creates a TokenService signing with an asymmetric
+// algorithm ("RS256" or "ES256") and a PEM-encoded private key. The kid is
+// embedded in issued tokens so verifiers can select the matching public
+// key.
+func NewTokenServiceWithKey(algorithm string, privateKeyPEM []byte, kid string) (*TokenService, error) {
+	var (
+		method  jwt.SigningMethod
+		signKey any
+		public  any
+	)
+	switch algorithm {
+	case "RS256":
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+		}
+		method, signKey, public = jwt.SigningMethodRS256, key, &key.PublicKey
+	case "ES256":
+		key, err := jwt.ParseECPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse EC private key: %w", err)
+		}
+		method, signKey, public = jwt.SigningMethodES256, key, &key.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+	return &TokenService{
+		method:             method,
+		kid:                kid,
+		signKey:            signKey,
+		verifyKeys:         map[string]any{kid: public},
+		clock:              systemClock{},
+		accessTTL:          AccessTokenTTL,
+		refreshTTL:         RefreshTokenTTL,
+		extendedRefreshTTL: ExtendedRefreshTokenTTL,
+	}, nil
+}
+
+// SetClock overrides the clock used for token issuance and validation.
+// Intended for tests; passing nil restores the system clock.
+func (s *TokenService) SetClock(clock Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if clock == nil {
+		clock = systemClock{}
+	}
+	s.clock = clock
+}
+
+// SetAccessTokenTTL overrides the access token lifetime.
+func (s *TokenService) SetAccessTokenTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessTTL = ttl
+}
+
+// SetRefreshTokenTTL overrides the refresh credential lifetime.
+func (s *TokenService) SetRefreshTokenTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshTTL = ttl
+}
+
+// SetExtendedRefreshTokenTTL overrides the refresh credential lifetime for
+// rememberMe sessions.
+func (s *TokenService) SetExtendedRefreshTokenTTL(ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.extendedRefreshTTL = ttl
+}
+
+// RotateSigningKey promotes a new PEM-encoded private key for signing
+// while keeping the previous keys registered for verification, so tokens
+// signed by the old key stay valid until they expire. Only the asymmetric
+// algorithms support rotation.
+func (s *TokenService) RotateSigningKey(privateKeyPEM []byte, kid string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var (
+		signKey any
+		public  any
+	)
+	switch s.method {
+	case jwt.SigningMethodRS256:
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return fmt.Errorf("failed to parse RSA private key: %w", err)
+		}
+		signKey, public = key, &key.PublicKey
+	case jwt.SigningMethodES256:
+		key, err := jwt.ParseECPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return fmt.Errorf("failed to parse EC private key: %w", err)
+		}
+		signKey, public = key, &key.PublicKey
+	default:
+		return fmt.Errorf("key rotation requires an asymmetric signing algorithm")
+	}
+	if _, exists := s.verifyKeys[kid]; exists {
+		return fmt.Errorf("key id %q is already registered", kid)
+	}
+	s.kid, s.signKey = kid, signKey
+	s.verifyKeys[kid] = public
+	return nil
+}
+
+// IssueToken signs an access token for the given user.
+func (s *TokenService) IssueToken(user *domain.User) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := s.clock.Now()
+	claims := Claims{
+		UserID:       user.ID,
+		Username:     user.Username,
+		Role:         user.Role,
+		TokenVersion: user.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.accessTTL)),
+		},
+	}
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.kid != "" {
+		token.Header["kid"] = s.kid
+	}
+	signed, err := token.SignedString(s.signKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// IssueRefreshToken signs a refresh token for the given user. rememberMe
+// selects the extended lifetime, and the claims note it so policy can
+// treat long sessions differently.
+func (s *TokenService) IssueRefreshToken(user *domain.User, rememberMe bool) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ttl := s.refreshTTL
+	if rememberMe {
+		ttl = s.extendedRefreshTTL
+	}
+	now := s.clock.Now()
+	claims := Claims{
+		UserID:       user.ID,
+		Username:     user.Username,
+		Role:         user.Role,
+		TokenType:    TokenTypeRefresh,
+		Extended:     rememberMe,
+		TokenVersion: user.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.kid != "" {
+		token.Header["kid"] = s.kid
+	}
+	signed, err := token.SignedString(s.signKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign refresh token: %w", err)
+	}
+	return signed, nil
+}
+
+// ValidateToken parses and verifies an access token string, returning its
+// claims. The verification key is selected by the token's kid header.
+// Refresh tokens are rejected so they cannot authenticate requests.
+func (s *TokenService) ValidateToken(tokenString string) (*Claims, error) {
+	claims, err := s.parseClaims(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != "" {
+		return nil, domain.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ValidateRefreshToken parses and verifies a refresh token string,
+// returning its claims. Access tokens are rejected.
+func (s *TokenService) ValidateRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := s.parseClaims(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh {
+		return nil, domain.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// parseClaims parses and verifies any issued token string.
+func (s *TokenService) parseClaims(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		if t.Method.Alg() != s.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		key, ok := s.verifyKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	}, jwt.WithTimeFunc(func() time.Time {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return s.clock.Now()
+	}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, domain.ErrTokenExpired
+		}
+		return nil, domain.ErrInvalidToken
+	}
+	if !token.Valid {
+		return nil, domain.ErrInvalidToken
+	}
+	return claims, nil
+}
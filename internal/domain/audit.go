@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit operation types. Every state-changing service method records one
+// of these so compliance can reconstruct who did what. Each is registered
+// with a description and severity in the operation registry; see
+// audit_registry.go.
+const (
+	OperationCreateEmployee = "CREATE_EMPLOYEE"
+	OperationUpdateEmployee = "UPDATE_EMPLOYEE"
+	OperationDeleteEmployee = "DELETE_EMPLOYEE"
+	OperationUpdateSalary   = "UPDATE_SALARY"
+	OperationLinkEmployee   = "LINK_EMPLOYEE"
+	OperationUnlinkEmployee = "UNLINK_EMPLOYEE"
+	OperationCreateUser     = "CREATE_USER"
+	OperationUpdateUser     = "UPDATE_USER"
+	OperationDeactivateUser = "DEACTIVATE_USER"
+	OperationChangePassword = "CHANGE_PASSWORD"
+	OperationChangeRole     = "CHANGE_ROLE"
+	OperationLogin          = "LOGIN"
+	// OperationLoginFailed records a failed authentication attempt, with a
+	// null user ID when the identifier did not resolve to an account, so
+	// security can review brute-force patterns.
+	OperationLoginFailed = "LOGIN_FAILED"
+	// OperationAnonymizeEmployee records a GDPR right-to-erasure request;
+	// see EmployeeService.AnonymizeEmployee.
+	OperationAnonymizeEmployee = "ANONYMIZE_EMPLOYEE"
+)
+
+// AuditLog records a single state-changing action: who performed it, what
+// entity it touched, and before/after snapshots of the changed fields.
+type AuditLog struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     *uuid.UUID     `json:"userId,omitempty"`
+	EmployeeID *uuid.UUID     `json:"employeeId,omitempty"`
+	Operation  string         `json:"operation"`
+	OldValues  map[string]any `json:"oldValues,omitempty"`
+	NewValues  map[string]any `json:"newValues,omitempty"`
+	IPAddress  string         `json:"ipAddress"`
+	UserAgent  string         `json:"userAgent"`
+	Location   *GeoLocation   `json:"location,omitempty"`
+	Timestamp  time.Time      `json:"timestamp"`
+	// RequestID correlates the log with the originating HTTP request.
+	RequestID string `json:"requestId,omitempty"`
+	// TenantID scopes the log to a hosted company; the zero UUID is the
+	// default tenant for single-tenant deployments.
+	TenantID uuid.UUID `json:"tenantId,omitempty"`
+	// Severity is derived from the operation registry rather than
+	// persisted, so re-ranking an operation reclassifies history too.
+	Severity AuditSeverity `json:"severity"`
+	// EntityDeleted marks logs whose referenced employee no longer exists.
+	// It is computed by the repository at query time, never persisted.
+	EntityDeleted bool `json:"entityDeleted,omitempty"`
+}
+
+// NewAuditLog constructs a validated audit record stamped with the current time.
+func NewAuditLog(userID, employeeID *uuid.UUID, operation string, oldValues, newValues map[string]any, ipAddress, userAgent string) (*AuditLog, error) {
+	info, ok := LookupOperation(operation)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOperation, operation)
+	}
+	return &AuditLog{
+		ID:         uuid.New(),
+		UserID:     userID,
+		EmployeeID: employeeID,
+		Operation:  operation,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+		IPAddress:  ipAddress,
+		UserAgent:  userAgent,
+		Timestamp:  time.Now().UTC(),
+		Severity:   info.Severity,
+	}, nil
+}
+
+// AuditLogFilter narrows audit-log queries. Zero-value fields are ignored.
+type AuditLogFilter struct {
+	UserID            *uuid.UUID
+	EmployeeID        *uuid.UUID
+	Operation         string
+	From              *time.Time
+	To                *time.Time
+	IPAddress         string
+	UserAgentContains string
+	// IncludeDeletedEntities also returns logs whose referenced employee
+	// has since been deleted, marked via AuditLog.EntityDeleted, so
+	// compliance can trace actions on removed records.
+	IncludeDeletedEntities bool
+}
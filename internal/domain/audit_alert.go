@@ -0,0 +1,19 @@
+package domain
+
+import "context"
+
+// AuditAlerter is notified of sensitive audit events as they are recorded,
+// for example to email security or post to a webhook when an admin role is
+// granted. Alerts fire inline with the operation, so implementations that
+// do slow I/O should hand off to their own goroutine.
+type AuditAlerter interface {
+	Alert(ctx context.Context, log *AuditLog)
+}
+
+// AuditAlertFunc adapts a plain function to the AuditAlerter interface.
+type AuditAlertFunc func(ctx context.Context, log *AuditLog)
+
+// Alert implements AuditAlerter.
+func (f AuditAlertFunc) Alert(ctx context.Context, log *AuditLog) {
+	f(ctx, log)
+}
@@ -0,0 +1,95 @@
+package domain
+
+import "fmt"
+
+// AuditSeverity ranks how sensitive an audit operation is, so downstream
+// tooling can filter or alert on the serious ones.
+type AuditSeverity string
+
+const (
+	SeverityInfo     AuditSeverity = "INFO"
+	SeverityWarning  AuditSeverity = "WARNING"
+	SeverityCritical AuditSeverity = "CRITICAL"
+)
+
+// IsValid reports whether s is one of the known severities.
+func (s AuditSeverity) IsValid() bool {
+	switch s {
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+		return true
+	}
+	return false
+}
+
+// OperationInfo describes a registered audit operation: its machine name,
+// a human-readable description, and how severe it is.
+type OperationInfo struct {
+	Name        string
+	Description string
+	Severity    AuditSeverity
+}
+
+// operationRegistry holds every operation NewAuditLog accepts, keyed by
+// name. It starts with the built-in operations; extensions add theirs via
+// RegisterOperation at startup.
+var operationRegistry = defaultOperationRegistry()
+
+// defaultOperationRegistry returns the built-in operations.
+func defaultOperationRegistry() map[string]OperationInfo {
+	registry := make(map[string]OperationInfo)
+	for _, info := range []OperationInfo{
+		{OperationCreateEmployee, "Employee record created", SeverityInfo},
+		{OperationUpdateEmployee, "Employee record updated", SeverityInfo},
+		{OperationDeleteEmployee, "Employee record deleted", SeverityWarning},
+		{OperationUpdateSalary, "Employee salary changed", SeverityWarning},
+		{OperationLinkEmployee, "Employee linked to user account", SeverityInfo},
+		{OperationUnlinkEmployee, "Employee unlinked from user account", SeverityInfo},
+		{OperationCreateUser, "User account created", SeverityInfo},
+		{OperationUpdateUser, "User account updated", SeverityInfo},
+		{OperationDeactivateUser, "User account deactivated", SeverityWarning},
+		{OperationChangePassword, "Account password changed", SeverityWarning},
+		{OperationChangeRole, "Account role changed", SeverityCritical},
+		{OperationLogin, "Successful login", SeverityInfo},
+		{OperationLoginFailed, "Failed login attempt", SeverityWarning},
+		{OperationAnonymizeEmployee, "Employee PII anonymized for erasure", SeverityCritical},
+	} {
+		registry[info.Name] = info
+	}
+	return registry
+}
+
+// RegisterOperation adds a custom audit operation so NewAuditLog accepts
+// it. Registering an already-known name or an invalid severity fails, so
+// typos surface at startup rather than silently shadowing an operation. It
+// is intended for startup configuration, not concurrent use.
+func RegisterOperation(name, description string, severity AuditSeverity) error {
+	if name == "" {
+		return fmt.Errorf("operation name is required")
+	}
+	if !severity.IsValid() {
+		return fmt.Errorf("invalid severity: %s", severity)
+	}
+	if _, exists := operationRegistry[name]; exists {
+		return fmt.Errorf("operation already registered: %s", name)
+	}
+	operationRegistry[name] = OperationInfo{Name: name, Description: description, Severity: severity}
+	return nil
+}
+
+// LookupOperation returns the registered info for an operation name.
+func LookupOperation(name string) (OperationInfo, bool) {
+	info, ok := operationRegistry[name]
+	return info, ok
+}
+
+// severityRanks orders severities for threshold comparisons.
+var severityRanks = map[AuditSeverity]int{
+	SeverityInfo:     0,
+	SeverityWarning:  1,
+	SeverityCritical: 2,
+}
+
+// AtLeast reports whether s is at least as severe as min.
+func (s AuditSeverity) AtLeast(min AuditSeverity) bool {
+	return severityRanks[s] >= severityRanks[min]
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// exportPageSize is how many audit records each export page fetches, keeping
+// memory bounded for large exports.
+const exportPageSize = 500
+
+// Export formats accepted by ExportAuditLogs.
+const (
+	ExportFormatJSONL = "jsonl"
+	ExportFormatCSV   = "csv"
+)
+
+// AuditService implements audit-log reporting use cases on top of the
+// audit-log repository.
+type AuditService struct {
+	auditRepo AuditLogRepository
+}
+
+// NewAuditService wires an AuditService from its dependencies.
+func NewAuditService(auditRepo AuditLogRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// ListAuditLogs returns a page of audit records matching the filter with a
+// total count.
+func (s *AuditService) ListAuditLogs(ctx context.Context, filter AuditLogFilter, pagination Pagination) ([]*AuditLog, int, error) {
+	if pagination.Limit <= 0 {
+		pagination.Limit = 20
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+	return s.auditRepo.List(ctx, filter, pagination)
+}
+
+// ExportAuditLogs streams all audit records matching the filter to w in the
+// given format ("jsonl" or "csv"). Records are fetched in pages so exports
+// of any size run in constant memory.
+func (s *AuditService) ExportAuditLogs(ctx context.Context, filter AuditLogFilter, format string, w io.Writer) error {
+	var write func(*AuditLog) error
+	var flush func() error
+
+	switch format {
+	case ExportFormatJSONL:
+		encoder := json.NewEncoder(w)
+		write = func(log *AuditLog) error { return encoder.Encode(log) }
+		flush = func() error { return nil }
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(auditCSVHeader); err != nil {
+			return fmt.Errorf("failed to write export header: %w", err)
+		}
+		write = func(log *AuditLog) error {
+			record, err := auditCSVRecord(log)
+			if err != nil {
+				return err
+			}
+			return cw.Write(record)
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		logs, total, err := s.auditRepo.List(ctx, filter, Pagination{Limit: exportPageSize, Offset: offset})
+		if err != nil {
+			return fmt.Errorf("failed to list audit logs: %w", err)
+		}
+		for _, log := range logs {
+			if err := write(log); err != nil {
+				return fmt.Errorf("failed to write export record: %w", err)
+			}
+		}
+		if offset+len(logs) >= total || len(logs) == 0 {
+			break
+		}
+	}
+	return flush()
+}
+
+// auditCSVHeader is the column order used by CSV exports.
+var auditCSVHeader = []string{
+	"id", "userId", "employeeId", "operation",
+	"oldValues", "newValues", "ipAddress", "userAgent", "timestamp",
+}
+
+// auditCSVRecord flattens one audit log into a CSV row; the before/after
+// snapshots are JSON-encoded into single columns.
+func auditCSVRecord(log *AuditLog) ([]string, error) {
+	oldValues, err := marshalSnapshot(log.OldValues)
+	if err != nil {
+		return nil, err
+	}
+	newValues, err := marshalSnapshot(log.NewValues)
+	if err != nil {
+		return nil, err
+	}
+	var userID, employeeID string
+	if log.UserID != nil {
+		userID = log.UserID.String()
+	}
+	if log.EmployeeID != nil {
+		employeeID = log.EmployeeID.String()
+	}
+	return []string{
+		log.ID.String(), userID, employeeID, log.Operation,
+		oldValues, newValues, log.IPAddress, log.UserAgent,
+		log.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+// marshalSnapshot JSON-encodes a value snapshot, keeping the column empty
+// when there is nothing to record.
+func marshalSnapshot(values map[string]any) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return string(data), nil
+}
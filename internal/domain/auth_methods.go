@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Supported values for the authenticate(method, credentials) dispatch.
+const (
+	AuthMethodUsername   = "username"
+	AuthMethodEmail      = "email"
+	AuthMethodEmployeeID = "employeeId"
+)
+
+// SetEmployeeRepository installs the employee lookup used by the employeeId
+// authentication method. Without it that method reports it is not
+// configured.
+func (s *UserService) SetEmployeeRepository(repo EmployeeRepository) {
+	s.employeeRepo = repo
+}
+
+// Authenticate dispatches to the resolver for the given method. Every path
+// ends in AuthenticateUser, so password verification and per-username rate
+// limiting apply uniformly; identifier lookups that fail count toward a rate
+// limit on the supplied identifier so the indirection cannot be used to
+// bypass it.
+func (s *UserService) Authenticate(ctx context.Context, method string, credentials map[string]any, ipAddress, userAgent string) (*User, error) {
+	password, _ := credentials["password"].(string)
+	switch method {
+	case AuthMethodUsername:
+		username, _ := credentials["username"].(string)
+		return s.AuthenticateUser(ctx, username, password, ipAddress, userAgent)
+	case AuthMethodEmail:
+		email, _ := credentials["email"].(string)
+		user, err := s.resolveByEmail(ctx, NormalizeEmail(email))
+		if err != nil {
+			return nil, err
+		}
+		return s.AuthenticateUser(ctx, user.Username, password, ipAddress, userAgent)
+	case AuthMethodEmployeeID:
+		raw, _ := credentials["employeeId"].(string)
+		user, err := s.resolveByEmployeeID(ctx, raw)
+		if err != nil {
+			return nil, err
+		}
+		return s.AuthenticateUser(ctx, user.Username, password, ipAddress, userAgent)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAuthMethod, method)
+	}
+}
+
+// resolveByEmail maps an email address to its user account. Unknown emails
+// record a failed attempt against the email so lookups are rate limited.
+func (s *UserService) resolveByEmail(ctx context.Context, email string) (*User, error) {
+	if s.isRateLimited(email) {
+		return nil, s.rateLimitError(email)
+	}
+	// Identity resolution runs before the tenant is known.
+	user, err := s.userRepo.FindByEmail(WithCrossTenantAccess(ctx), email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		s.recordFailedAttempt(email)
+		return nil, ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+// resolveByEmployeeID maps an employee ID to its user account through the
+// employee's explicit account link, falling back to a matching email for
+// records linked before the UserID field existed. Unknown or unlinked IDs
+// record a failed attempt against the supplied ID so lookups are rate
+// limited.
+func (s *UserService) resolveByEmployeeID(ctx context.Context, raw string) (*User, error) {
+	if s.employeeRepo == nil {
+		return nil, fmt.Errorf("employeeId authentication is not configured")
+	}
+	if s.isRateLimited(raw) {
+		return nil, s.rateLimitError(raw)
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		s.recordFailedAttempt(raw)
+		return nil, ErrInvalidCredentials
+	}
+	// Identity resolution runs before the tenant is known.
+	ctx = WithCrossTenantAccess(ctx)
+	employee, err := s.employeeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrEmployeeNotFound) {
+			s.recordFailedAttempt(raw)
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to get employee: %w", err)
+	}
+	var user *User
+	if employee.UserID != nil {
+		user, err = s.userRepo.GetByID(ctx, *employee.UserID)
+		if errors.Is(err, ErrUserNotFound) {
+			user, err = nil, nil
+		}
+	} else {
+		user, err = s.userRepo.FindByEmail(ctx, employee.Email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		s.recordFailedAttempt(raw)
+		return nil, ErrInvalidCredentials
+	}
+	return user, nil
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSalaryCurrency is used when neither the caller nor the employee's
+// address country determines a currency.
+const DefaultSalaryCurrency = "USD"
+
+// knownCurrencies lists the ISO 4217 codes the service accepts for
+// salaries. The set covers the countries the company operates in; extend
+// it as new entities open.
+var knownCurrencies = map[string]struct{}{
+	"USD": {}, "EUR": {}, "GBP": {}, "CAD": {}, "AUD": {}, "NZD": {},
+	"JPY": {}, "CNY": {}, "KRW": {}, "INR": {}, "SGD": {}, "HKD": {},
+	"CHF": {}, "SEK": {}, "NOK": {}, "DKK": {}, "PLN": {}, "CZK": {},
+	"BRL": {}, "MXN": {}, "ZAR": {}, "AED": {}, "VND": {}, "THB": {},
+	"IDR": {}, "MYR": {}, "PHP": {},
+}
+
+// countryCurrencies maps lower-cased country names and ISO 3166 codes to
+// their default salary currency.
+var countryCurrencies = map[string]string{
+	"us": "USD", "usa": "USD", "united states": "USD",
+	"gb": "GBP", "uk": "GBP", "united kingdom": "GBP",
+	"ca": "CAD", "canada": "CAD",
+	"au": "AUD", "australia": "AUD",
+	"nz": "NZD", "new zealand": "NZD",
+	"de": "EUR", "germany": "EUR",
+	"fr": "EUR", "france": "EUR",
+	"es": "EUR", "spain": "EUR",
+	"it": "EUR", "italy": "EUR",
+	"nl": "EUR", "netherlands": "EUR",
+	"ie": "EUR", "ireland": "EUR",
+	"jp": "JPY", "japan": "JPY",
+	"cn": "CNY", "china": "CNY",
+	"kr": "KRW", "south korea": "KRW",
+	"in": "INR", "india": "INR",
+	"sg": "SGD", "singapore": "SGD",
+	"hk": "HKD", "hong kong": "HKD",
+	"ch": "CHF", "switzerland": "CHF",
+	"br": "BRL", "brazil": "BRL",
+	"mx": "MXN", "mexico": "MXN",
+	"vn": "VND", "vietnam": "VND",
+	"th": "THB", "thailand": "THB",
+	"id": "IDR", "indonesia": "IDR",
+	"my": "MYR", "malaysia": "MYR",
+	"ph": "PHP", "philippines": "PHP",
+}
+
+// IsValidCurrency reports whether code is a known ISO 4217 currency code.
+func IsValidCurrency(code string) bool {
+	_, ok := knownCurrencies[strings.ToUpper(code)]
+	return ok
+}
+
+// DefaultCurrencyForCountry returns the default salary currency for a
+// country name or ISO 3166 code, falling back to DefaultSalaryCurrency
+// when the country is unknown or empty.
+func DefaultCurrencyForCountry(country string) string {
+	if c, ok := countryCurrencies[strings.ToLower(strings.TrimSpace(country))]; ok {
+		return c
+	}
+	return DefaultSalaryCurrency
+}
+
+// FXRates converts amounts between currencies via per-currency rates
+// expressed against a single base (rate = units of currency per one unit
+// of Base). Deployments install rates with SetFXRates so cross-currency
+// salary comparisons can normalize instead of comparing raw numbers.
+type FXRates struct {
+	Base  string
+	Rates map[string]float64
+}
+
+// Convert translates an amount from one currency to another. The base
+// currency itself always has an implicit rate of 1.
+func (f *FXRates) Convert(amount float64, from, to string) (float64, error) {
+	fromRate, err := f.rate(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := f.rate(to)
+	if err != nil {
+		return 0, err
+	}
+	return amount / fromRate * toRate, nil
+}
+
+func (f *FXRates) rate(currency string) (float64, error) {
+	currency = strings.ToUpper(currency)
+	if currency == strings.ToUpper(f.Base) {
+		return 1, nil
+	}
+	rate, ok := f.Rates[currency]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("no FX rate configured for %s", currency)
+	}
+	return rate, nil
+}
+
+// fxRates holds the optionally configured conversion rates.
+var fxRates *FXRates
+
+// SetFXRates installs conversion rates for cross-currency salary
+// normalization. Passing nil removes them. It is intended for startup
+// configuration, not concurrent use.
+func SetFXRates(rates *FXRates) {
+	fxRates = rates
+}
+
+// SalaryIn returns the employee's salary converted to the given currency
+// using the configured FX rates. Without configured rates only same-
+// currency requests succeed; callers should then group by currency
+// instead.
+func (e *Employee) SalaryIn(currency string) (float64, error) {
+	if strings.EqualFold(e.SalaryCurrency, currency) {
+		return e.Salary, nil
+	}
+	if fxRates == nil {
+		return 0, fmt.Errorf("no FX rates configured; cannot convert %s to %s", e.SalaryCurrency, currency)
+	}
+	return fxRates.Convert(e.Salary, e.SalaryCurrency, currency)
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EncodeCursor encodes a list offset as an opaque pagination cursor shared
+// by the repositories and the GraphQL connection types.
+func EncodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+// DecodeCursor decodes a cursor produced by EncodeCursor back to an offset.
+func DecodeCursor(cursor string) (int, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor: %w", err)
+	}
+	s := string(raw)
+	if !strings.HasPrefix(s, "offset:") {
+		return 0, fmt.Errorf("invalid cursor format")
+	}
+	offset, err := strconv.Atoi(strings.TrimPrefix(s, "offset:"))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid cursor offset")
+	}
+	return offset, nil
+}
+
+// EncodeAuditCursor encodes an audit log's position as an opaque keyset
+// cursor. Unlike offset cursors, keyset cursors stay stable when rows are
+// inserted ahead of them.
+func EncodeAuditCursor(timestamp time.Time, id uuid.UUID) string {
+	raw := fmt.Sprintf("audit:%d:%s", timestamp.UnixNano(), id)
+	return base64.StdEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeAuditCursor decodes a cursor produced by EncodeAuditCursor.
+func DecodeAuditCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	parts := strings.SplitN(string(raw), ":", 3)
+	if len(parts) != 3 || parts[0] != "audit" {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor format")
+	}
+	nanos, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor timestamp")
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor id")
+	}
+	return time.Unix(0, nanos), id, nil
+}
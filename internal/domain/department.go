@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Department is an organizational unit. Employees reference departments by
+// ID so the canonical name lives in one place instead of being retyped as
+// free text; ParentID links sub-departments into a hierarchy.
+type Department struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	ParentID  *uuid.UUID `json:"parentId,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// NewDepartment constructs a validated Department with generated ID and
+// timestamps.
+func NewDepartment(name string, parentID *uuid.UUID) (*Department, error) {
+	now := time.Now().UTC()
+	d := &Department{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		ParentID:  parentID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Validate checks all invariants on the department record.
+func (d *Department) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("department name is required")
+	}
+	if len(d.Name) > 100 {
+		return fmt.Errorf("department name must be at most 100 characters")
+	}
+	if d.ParentID != nil && *d.ParentID == d.ID {
+		return fmt.Errorf("department cannot be its own parent")
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// DepartmentHeadcountProjection is a read model of live employee counts
+// per department, maintained from the employee lifecycle events so
+// headcount queries never scan the employee table. Only ACTIVE employees
+// count toward a department's headcount.
+type DepartmentHeadcountProjection struct {
+	mu     sync.RWMutex
+	counts map[string]int
+	// departments remembers which department each counted employee is in,
+	// so transfers and deletions decrement the right bucket.
+	departments map[uuid.UUID]string
+}
+
+// NewDepartmentHeadcountProjection creates an empty projection.
+func NewDepartmentHeadcountProjection() *DepartmentHeadcountProjection {
+	return &DepartmentHeadcountProjection{
+		counts:      make(map[string]int),
+		departments: make(map[uuid.UUID]string),
+	}
+}
+
+// Register subscribes the projection to the employee lifecycle events.
+func (p *DepartmentHeadcountProjection) Register(dispatcher *EventDispatcher) {
+	for _, eventType := range []string{"EmployeeCreated", "EmployeeUpdated", "EmployeeDeleted"} {
+		dispatcher.Subscribe(eventType, p.handle)
+	}
+}
+
+// GetHeadcount returns the projected number of active employees in the
+// department.
+func (p *DepartmentHeadcountProjection) GetHeadcount(ctx context.Context, department string) (int, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.counts[department], nil
+}
+
+// Rebuild resets the projection and replays stored events in order, as
+// loaded from an event store, so the read model can be reconstructed after
+// a restart or a bug fix.
+func (p *DepartmentHeadcountProjection) Rebuild(events []*StoredEvent) error {
+	p.mu.Lock()
+	p.counts = make(map[string]int)
+	p.departments = make(map[uuid.UUID]string)
+	p.mu.Unlock()
+
+	for _, stored := range events {
+		switch stored.EventType {
+		case "EmployeeCreated", "EmployeeUpdated":
+			var payload struct {
+				Employee *Employee `json:"employee"`
+			}
+			if err := json.Unmarshal(stored.Payload, &payload); err != nil {
+				return fmt.Errorf("failed to decode %s event: %w", stored.EventType, err)
+			}
+			if payload.Employee != nil {
+				p.track(payload.Employee)
+			}
+		case "EmployeeDeleted":
+			p.untrack(stored.AggregateID)
+		}
+	}
+	return nil
+}
+
+// handle routes a dispatched event into the projection.
+func (p *DepartmentHeadcountProjection) handle(ctx context.Context, event DomainEvent) {
+	switch e := event.(type) {
+	case *EmployeeCreatedEvent:
+		p.track(e.Employee)
+	case *EmployeeUpdatedEvent:
+		p.track(e.Employee)
+	case *EmployeeDeletedEvent:
+		p.untrack(e.AggregateID())
+	}
+}
+
+// track records the employee's current department, moving the count when
+// the employee transferred and dropping it when they are no longer active.
+func (p *DepartmentHeadcountProjection) track(e *Employee) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	previous, counted := p.departments[e.ID]
+	if !e.IsActive() {
+		if counted {
+			p.counts[previous]--
+			delete(p.departments, e.ID)
+		}
+		return
+	}
+	if counted {
+		if previous == e.Department {
+			return
+		}
+		p.counts[previous]--
+	}
+	p.counts[e.Department]++
+	p.departments[e.ID] = e.Department
+}
+
+// untrack removes a deleted employee from the projection.
+func (p *DepartmentHeadcountProjection) untrack(id uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if department, counted := p.departments[id]; counted {
+		p.counts[department]--
+		delete(p.departments, id)
+	}
+}
@@ -0,0 +1,399 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// EmployeeStatus represents the lifecycle state of an employee record.
+type EmployeeStatus string
+
+const (
+	StatusActive     EmployeeStatus = "ACTIVE"
+	StatusInactive   EmployeeStatus = "INACTIVE"
+	StatusTerminated EmployeeStatus = "TERMINATED"
+	StatusOnLeave    EmployeeStatus = "ON_LEAVE"
+)
+
+// IsValid reports whether s is one of the known employee statuses.
+func (s EmployeeStatus) IsValid() bool {
+	switch s {
+	case StatusActive, StatusInactive, StatusTerminated, StatusOnLeave:
+		return true
+	}
+	return false
+}
+
+// EmploymentType distinguishes full-time staff from contractors and interns.
+type EmploymentType string
+
+const (
+	EmploymentFullTime   EmploymentType = "FULL_TIME"
+	EmploymentPartTime   EmploymentType = "PART_TIME"
+	EmploymentContractor EmploymentType = "CONTRACTOR"
+	EmploymentIntern     EmploymentType = "INTERN"
+)
+
+// IsValid reports whether t is one of the known employment types.
+func (t EmploymentType) IsValid() bool {
+	switch t {
+	case EmploymentFullTime, EmploymentPartTime, EmploymentContractor, EmploymentIntern:
+		return true
+	}
+	return false
+}
+
+// Address is the postal address attached to an employee record.
+type Address struct {
+	Street     string `json:"street"`
+	City       string `json:"city"`
+	State      string `json:"state"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+// addressFieldMaxLength bounds every address field; postal codes are held
+// to the tighter postalCodeMaxLength.
+const (
+	addressFieldMaxLength = 100
+	postalCodeMaxLength   = 20
+)
+
+// postalCodePattern accepts the digits, letters, spaces, and hyphens used
+// by postal codes worldwide.
+var postalCodePattern = regexp.MustCompile(`^[A-Za-z0-9 -]+$`)
+
+// Validate checks a fully specified address: street, city, and country are
+// required, all fields are length-bounded, and the postal code, when
+// given, must look like one.
+func (a *Address) Validate() error {
+	if a.Street == "" {
+		return NewFieldError("street", "is required")
+	}
+	if a.City == "" {
+		return NewFieldError("city", "is required")
+	}
+	if a.Country == "" {
+		return NewFieldError("country", "is required")
+	}
+	for field, value := range map[string]string{
+		"street": a.Street, "city": a.City, "state": a.State, "country": a.Country,
+	} {
+		if len(value) > addressFieldMaxLength {
+			return NewFieldError(field, fmt.Sprintf("must be at most %d characters", addressFieldMaxLength))
+		}
+	}
+	if a.PostalCode != "" {
+		if len(a.PostalCode) > postalCodeMaxLength {
+			return NewFieldError("postalCode", fmt.Sprintf("must be at most %d characters", postalCodeMaxLength))
+		}
+		if !postalCodePattern.MatchString(a.PostalCode) {
+			return NewFieldError("postalCode", "contains invalid characters")
+		}
+	}
+	return nil
+}
+
+// Employee is the aggregate root for employee records. Version implements
+// optimistic locking: the repository increments it on every update and
+// rejects writes whose expected version is stale.
+type Employee struct {
+	ID             uuid.UUID      `json:"id"`
+	FirstName      string         `json:"firstName"`
+	LastName       string         `json:"lastName"`
+	Email          string         `json:"email"`
+	Phone          string         `json:"phone"`
+	Department     string         `json:"department"`
+	DepartmentID   *uuid.UUID     `json:"departmentId,omitempty"`
+	Position       string         `json:"position"`
+	Salary         float64        `json:"salary"`
+	SalaryCurrency string         `json:"salaryCurrency"`
+	HireDate       time.Time      `json:"hireDate"`
+	Status         EmployeeStatus `json:"status"`
+	EmploymentType EmploymentType `json:"employmentType"`
+	ManagerID      *uuid.UUID     `json:"managerId,omitempty"`
+	UserID         *uuid.UUID     `json:"userId,omitempty"`
+	Address        *Address       `json:"address,omitempty"`
+	// TenantID scopes the record to a hosted company; the zero UUID is the
+	// default tenant for single-tenant deployments.
+	TenantID  uuid.UUID `json:"tenantId,omitempty"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewEmployee constructs a validated Employee with generated ID and
+// timestamps. New employees start ACTIVE at version 1.
+func NewEmployee(firstName, lastName, email, phone, department, position string, salary float64, hireDate time.Time, managerID *uuid.UUID) (*Employee, error) {
+	now := time.Now().UTC()
+	e := &Employee{
+		ID:             uuid.New(),
+		FirstName:      NormalizeName(firstName),
+		LastName:       NormalizeName(lastName),
+		Email:          NormalizeEmail(email),
+		Phone:          normalizePhone(strings.TrimSpace(phone)),
+		Department:     strings.TrimSpace(department),
+		Position:       strings.TrimSpace(position),
+		Salary:         salary,
+		HireDate:       hireDate.UTC(),
+		Status:         StatusActive,
+		EmploymentType: EmploymentFullTime,
+		ManagerID:      managerID,
+		Version:        1,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	e.EnsureSalaryCurrency()
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// EnsureSalaryCurrency defaults the salary currency from the employee's
+// address country when none was provided explicitly.
+func (e *Employee) EnsureSalaryCurrency() {
+	if e.SalaryCurrency != "" {
+		return
+	}
+	country := ""
+	if e.Address != nil {
+		country = e.Address.Country
+	}
+	e.SalaryCurrency = DefaultCurrencyForCountry(country)
+}
+
+// Validate checks all invariants on the employee record.
+func (e *Employee) Validate() error {
+	if err := validateName("first name", e.FirstName); err != nil {
+		return err
+	}
+	if err := validateName("last name", e.LastName); err != nil {
+		return err
+	}
+	if err := validateEmail(e.Email); err != nil {
+		return err
+	}
+	if err := validatePhone(e.Phone); err != nil {
+		return err
+	}
+	if e.Department == "" {
+		return fmt.Errorf("department is required")
+	}
+	if e.Position == "" {
+		return fmt.Errorf("position is required")
+	}
+	if err := validateSalary(e.Salary); err != nil {
+		return err
+	}
+	if e.SalaryCurrency != "" && !IsValidCurrency(e.SalaryCurrency) {
+		return fmt.Errorf("unknown salary currency: %s", e.SalaryCurrency)
+	}
+	if e.HireDate.IsZero() {
+		return fmt.Errorf("hire date is required")
+	}
+	if e.HireDate.After(time.Now().AddDate(1, 0, 0)) {
+		return fmt.Errorf("hire date cannot be more than a year in the future")
+	}
+	if !e.Status.IsValid() {
+		return fmt.Errorf("invalid employee status: %s", e.Status)
+	}
+	if !e.EmploymentType.IsValid() {
+		return fmt.Errorf("invalid employment type: %s", e.EmploymentType)
+	}
+	return nil
+}
+
+// FullName returns "First Last", omitting whichever part is empty so a
+// single-name record never gains stray spaces.
+func (e *Employee) FullName() string {
+	return strings.TrimSpace(e.FirstName + " " + e.LastName)
+}
+
+// Initials returns the upper-cased first letter of each name part, e.g.
+// "JD" for Jane Doe.
+func (e *Employee) Initials() string {
+	var initials []rune
+	for _, part := range []string{e.FirstName, e.LastName} {
+		for _, r := range part {
+			initials = append(initials, unicode.ToUpper(r))
+			break
+		}
+	}
+	return string(initials)
+}
+
+// DisplayName returns the full name, falling back to the email address and
+// finally the ID so there is always something to render.
+func (e *Employee) DisplayName() string {
+	if name := e.FullName(); name != "" {
+		return name
+	}
+	if e.Email != "" {
+		return e.Email
+	}
+	return e.ID.String()
+}
+
+// Clone returns a deep copy of the employee. Pointer fields (manager and
+// user links, address) are copied so mutating the clone never touches the
+// original; UpdateEmployee relies on this to validate changes on a copy.
+func (e *Employee) Clone() *Employee {
+	clone := *e
+	if e.ManagerID != nil {
+		id := *e.ManagerID
+		clone.ManagerID = &id
+	}
+	if e.DepartmentID != nil {
+		id := *e.DepartmentID
+		clone.DepartmentID = &id
+	}
+	if e.UserID != nil {
+		id := *e.UserID
+		clone.UserID = &id
+	}
+	if e.Address != nil {
+		addr := *e.Address
+		clone.Address = &addr
+	}
+	return &clone
+}
+
+// IsActive reports whether the employee is currently active.
+func (e *Employee) IsActive() bool {
+	return e.Status == StatusActive
+}
+
+// YearsOfService returns the number of full years since the hire date. It
+// is zero during the first year of employment.
+func (e *Employee) YearsOfService() int {
+	now := time.Now().UTC()
+	years := now.Year() - e.HireDate.Year()
+	if years > 0 && now.Before(anniversaryInYear(e.HireDate, now.Year())) {
+		years--
+	}
+	if years < 0 {
+		return 0
+	}
+	return years
+}
+
+// NextAnniversary returns the next hire-date anniversary on or after the
+// given time. Feb 29 hire dates are observed on Mar 1 in non-leap years.
+func (e *Employee) NextAnniversary(after time.Time) time.Time {
+	anniversary := anniversaryInYear(e.HireDate, after.Year())
+	if anniversary.Before(after) {
+		anniversary = anniversaryInYear(e.HireDate, after.Year()+1)
+	}
+	return anniversary
+}
+
+// anniversaryInYear places the hire date's month and day into the given
+// year. time.Date normalizes Feb 29 to Mar 1 when the year is not a leap
+// year.
+func anniversaryInYear(hireDate time.Time, year int) time.Time {
+	return time.Date(year, hireDate.Month(), hireDate.Day(), 0, 0, 0, 0, hireDate.Location())
+}
+
+// UpdateSalary sets a new salary after range validation and bumps UpdatedAt.
+func (e *Employee) UpdateSalary(salary float64) error {
+	if err := validateSalary(salary); err != nil {
+		return err
+	}
+	e.Salary = salary
+	e.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// validateName enforces the configured name rules; see NameRules.
+func validateName(field, name string) error {
+	return nameRules.Validate(field, name)
+}
+
+// phonePattern accepts E.164-style numbers after normalization: an
+// optional +, then 7 to 15 digits.
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{7,15}$`)
+
+// normalizePhone strips the spaces and dashes UI input tends to include.
+func normalizePhone(phone string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(phone)
+}
+
+// validatePhone accepts an empty phone (the field is optional) or an
+// E.164-format number.
+func validatePhone(phone string) error {
+	if phone == "" {
+		return nil
+	}
+	if !phonePattern.MatchString(phone) {
+		return fmt.Errorf("phone must be an E.164 number such as +14155550123")
+	}
+	return nil
+}
+
+// NormalizeEmail trims and lowercases an email so that addresses differing
+// only by case map to the same stored value.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validateEmail performs a light-weight structural check; true uniqueness
+// is enforced by the repository.
+func validateEmail(email string) error {
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+	at := strings.Index(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return fmt.Errorf("invalid email format")
+	}
+	if !strings.Contains(email[at+1:], ".") {
+		return fmt.Errorf("invalid email format")
+	}
+	if strings.ContainsAny(email, " \t\n") {
+		return fmt.Errorf("invalid email format")
+	}
+	return nil
+}
+
+// SalaryBounds are the limits enforced on employee salaries. Salaries must
+// be strictly greater than MinSalary and no more than MaxSalary.
+type SalaryBounds struct {
+	MinSalary float64
+	MaxSalary float64
+}
+
+// DefaultSalaryBounds returns the historical limits: positive and at most
+// 1,000,000.
+func DefaultSalaryBounds() *SalaryBounds {
+	return &SalaryBounds{MinSalary: 0, MaxSalary: 1_000_000}
+}
+
+// salaryBounds are the bounds consulted by validateSalary.
+var salaryBounds = DefaultSalaryBounds()
+
+// SetSalaryBounds replaces the configured salary bounds. Passing nil
+// restores the default. It is intended for startup configuration, not
+// concurrent use.
+func SetSalaryBounds(bounds *SalaryBounds) {
+	if bounds == nil {
+		bounds = DefaultSalaryBounds()
+	}
+	salaryBounds = bounds
+}
+
+// validateSalary keeps salaries inside the configured range.
+func validateSalary(salary float64) error {
+	if salary <= salaryBounds.MinSalary {
+		return fmt.Errorf("%w: salary must be greater than %.2f", ErrSalaryTooLow, salaryBounds.MinSalary)
+	}
+	if salaryBounds.MaxSalary > 0 && salary > salaryBounds.MaxSalary {
+		return fmt.Errorf("%w: salary must be at most %.2f", ErrSalaryTooHigh, salaryBounds.MaxSalary)
+	}
+	return nil
+}
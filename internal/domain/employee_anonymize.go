@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnonymizedValue is the tombstone written over erased personal data, both
+// on the employee record and in scrubbed audit snapshots.
+const AnonymizedValue = "[REDACTED]"
+
+// piiSnapshotFields lists the audit-snapshot keys that carry personal
+// data. ScrubEmployeeSnapshots replaces exactly these; the non-personal
+// fields (department, salary, status, ...) stay intact for aggregates.
+var piiSnapshotFields = []string{"firstName", "lastName", "email", "phone", "address"}
+
+// AnonymizeEmployee fulfils a right-to-erasure request: the employee's
+// name, email, phone, and address are replaced with tombstone values while
+// the record itself — and with it headcount, salary aggregates, and the
+// org structure — survives. When scrubAuditSnapshots is set, the same
+// fields are overwritten in the employee's historical audit snapshots; the
+// audit entries themselves are preserved so the action trail stays
+// complete. The erasure is recorded as ANONYMIZE_EMPLOYEE carrying only
+// the tombstones, and no domain event is emitted so the erased values do
+// not land in the append-only event store.
+func (s *EmployeeService) AnonymizeEmployee(ctx context.Context, id uuid.UUID, scrubAuditSnapshots bool, userID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	employee.FirstName = AnonymizedValue
+	employee.LastName = AnonymizedValue
+	// The tombstone email embeds the record ID so the uniqueness
+	// constraint holds without retaining the real address.
+	employee.Email = fmt.Sprintf("anonymized.%s@redacted.invalid", employee.ID)
+	employee.Phone = ""
+	employee.Address = nil
+	employee.UpdatedAt = time.Now().UTC()
+
+	err = s.transact(ctx, func(ctx context.Context) error {
+		if err := s.employeeRepo.Update(ctx, employee); err != nil {
+			return fmt.Errorf("failed to save employee: %w", err)
+		}
+		if scrubAuditSnapshots {
+			if err := s.auditRepo.ScrubEmployeeSnapshots(ctx, employee.ID, piiSnapshotFields); err != nil {
+				return fmt.Errorf("failed to scrub audit snapshots: %w", err)
+			}
+		}
+		// Snapshotting the old values here would re-capture the very data
+		// being erased, so the log carries only what happened.
+		return s.writeAuditLog(ctx, &userID, &employee.ID, OperationAnonymizeEmployee, nil,
+			map[string]any{"scrubbedAuditSnapshots": scrubAuditSnapshots}, ipAddress, userAgent)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SetTransactor installs the storage transaction runner used by bulk
+// operations, so their writes commit or roll back together. Without one,
+// writes run sequentially outside a transaction; the in-memory
+// repositories need no more.
+func (s *EmployeeService) SetTransactor(transactor Transactor) {
+	s.transactor = transactor
+}
+
+// transact runs fn inside a transaction when a Transactor is installed,
+// and directly otherwise.
+func (s *EmployeeService) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.transactor == nil {
+		return fn(ctx)
+	}
+	return s.transactor.Transact(ctx, fn)
+}
+
+// BulkOptions adjusts how a bulk operation runs. The zero value performs
+// the operation for real with the standard safety guards.
+type BulkOptions struct {
+	// ConfirmAll acknowledges a filter that matches every employee, which
+	// is otherwise rejected with ErrBulkUpdateNotConfirmed.
+	ConfirmAll bool
+	// DryRun computes and returns exactly what a real run would change
+	// without writing anything: no updates, no audit logs, no events. The
+	// returned summary has the same shape as a real run's.
+	DryRun bool
+}
+
+// pendingEmployeeUpdate is one prepared, validated change awaiting its
+// write inside a bulk update's transaction.
+type pendingEmployeeUpdate struct {
+	original *Employee
+	updated  *Employee
+	changed  []string
+}
+
+// UpdateEmployeesByFilter applies the same field updates to every employee
+// matching the filter, returning how many were changed. All updates are
+// parsed and validated up front, then written inside a single transaction
+// with one audit log and one EmployeeUpdatedEvent per employee, so a bad
+// record aborts the whole batch before anything is persisted. An empty
+// filter would touch every employee; that requires opts.ConfirmAll,
+// otherwise ErrBulkUpdateNotConfirmed is returned. With opts.DryRun the
+// count is computed through the same validation but nothing is written.
+func (s *EmployeeService) UpdateEmployeesByFilter(ctx context.Context, filter EmployeeFilter, updates map[string]any, opts BulkOptions, userID uuid.UUID, ipAddress, userAgent string) (int, error) {
+	if filter == (EmployeeFilter{}) && !opts.ConfirmAll {
+		return 0, ErrBulkUpdateNotConfirmed
+	}
+	matched, err := s.collectEmployees(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var pending []pendingEmployeeUpdate
+	for _, employee := range matched {
+		updated := employee.Clone()
+		changed, err := applyEmployeeUpdates(updated, updates)
+		if err != nil {
+			return 0, err
+		}
+		changed = pruneUnchangedFields(employee, updated, changed)
+		if len(changed) == 0 {
+			continue
+		}
+		if err := s.validateEmployeeUpdate(ctx, updated); err != nil {
+			return 0, fmt.Errorf("employee %s: %w", employee.ID, err)
+		}
+		updated.UpdatedAt = time.Now().UTC()
+		pending = append(pending, pendingEmployeeUpdate{original: employee, updated: updated, changed: changed})
+	}
+	if len(pending) == 0 || opts.DryRun {
+		return len(pending), nil
+	}
+
+	err = s.transact(ctx, func(ctx context.Context) error {
+		for _, p := range pending {
+			if err := s.employeeRepo.Update(ctx, p.updated); err != nil {
+				return fmt.Errorf("failed to save employee %s: %w", p.updated.ID, err)
+			}
+			if err := s.writeAuditLog(ctx, &userID, &p.updated.ID, OperationUpdateEmployee,
+				createEmployeeSnapshot(p.original), createEmployeeSnapshot(p.updated), ipAddress, userAgent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Events are emitted only after the transaction commits, so handlers
+	// never observe changes that were rolled back.
+	for _, p := range pending {
+		s.emitEvent(ctx, NewEmployeeUpdatedEvent(p.updated, p.changed))
+	}
+	return len(pending), nil
+}
+
+// AdjustSalaryByPercent raises (or lowers, for a negative percent) the
+// salary of each listed employee by the given percentage, rounded to
+// cents. Every adjusted salary is validated against the configured bounds
+// before anything is written, then the batch commits in one transaction
+// with an OperationUpdateSalary audit entry recording the old and new
+// values, and one EmployeeSalaryChangedEvent per employee. It returns how
+// many employees were adjusted. With opts.DryRun the count is computed
+// through the same validation but nothing is written.
+func (s *EmployeeService) AdjustSalaryByPercent(ctx context.Context, ids []uuid.UUID, percent float64, opts BulkOptions, userID uuid.UUID, ipAddress, userAgent string) (int, error) {
+	if percent == 0 {
+		return 0, nil
+	}
+	if percent <= -100 {
+		return 0, fmt.Errorf("%w: percent must be greater than -100", ErrInvalidSalary)
+	}
+
+	type salaryAdjustment struct {
+		employee  *Employee
+		oldSalary float64
+	}
+	var pending []salaryAdjustment
+	for _, id := range ids {
+		employee, err := s.GetEmployee(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		oldSalary := employee.Salary
+		newSalary := math.Round(oldSalary*(100+percent)) / 100
+		if err := employee.UpdateSalary(newSalary); err != nil {
+			return 0, fmt.Errorf("employee %s: %w", id, err)
+		}
+		pending = append(pending, salaryAdjustment{employee: employee, oldSalary: oldSalary})
+	}
+	if len(pending) == 0 || opts.DryRun {
+		return len(pending), nil
+	}
+
+	err := s.transact(ctx, func(ctx context.Context) error {
+		for _, p := range pending {
+			if err := s.employeeRepo.Update(ctx, p.employee); err != nil {
+				return fmt.Errorf("failed to save employee %s: %w", p.employee.ID, err)
+			}
+			if err := s.writeAuditLog(ctx, &userID, &p.employee.ID, OperationUpdateSalary,
+				map[string]any{"salary": p.oldSalary}, map[string]any{"salary": p.employee.Salary},
+				ipAddress, userAgent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range pending {
+		s.emitEvent(ctx, NewEmployeeSalaryChangedEvent(p.employee.ID, p.oldSalary, p.employee.Salary))
+	}
+	return len(pending), nil
+}
+
+// collectEmployees gathers every employee matching the filter through the
+// repository's streaming query.
+func (s *EmployeeService) collectEmployees(ctx context.Context, filter EmployeeFilter) ([]*Employee, error) {
+	var matched []*Employee
+	err := s.employeeRepo.StreamEmployees(ctx, filter, func(e *Employee) error {
+		matched = append(matched, e)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream employees: %w", err)
+	}
+	return matched, nil
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmployeeDataPackage bundles everything stored about one employee for a
+// subject-access request: the record itself, the salary and department
+// histories reconstructed from the audit trail, and every audit log that
+// references the employee. The struct serializes to JSON as-is.
+type EmployeeDataPackage struct {
+	GeneratedAt       time.Time     `json:"generatedAt"`
+	Employee          *Employee     `json:"employee"`
+	SalaryHistory     []FieldChange `json:"salaryHistory,omitempty"`
+	DepartmentHistory []FieldChange `json:"departmentHistory,omitempty"`
+	AuditLogs         []*AuditLog   `json:"auditLogs"`
+}
+
+// FieldChange is one historical change to a tracked employee field,
+// reconstructed from audit-log snapshots. OldValue is absent for the
+// initial value recorded at creation.
+type FieldChange struct {
+	Timestamp time.Time `json:"timestamp"`
+	OldValue  any       `json:"oldValue,omitempty"`
+	NewValue  any       `json:"newValue,omitempty"`
+}
+
+// ExportEmployeeData assembles the data package for a GDPR data-portability
+// request. Only admins and the subject themselves — the user account linked
+// to the employee record — may export; anyone else gets ErrUnauthorized.
+func (s *EmployeeService) ExportEmployeeData(ctx context.Context, id uuid.UUID, requester *User) (*EmployeeDataPackage, error) {
+	if requester == nil {
+		return nil, ErrUnauthenticated
+	}
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if requester.Role != RoleAdmin && (employee.UserID == nil || *employee.UserID != requester.ID) {
+		return nil, ErrUnauthorized
+	}
+
+	logs, err := s.collectEmployeeAuditLogs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &EmployeeDataPackage{
+		GeneratedAt: time.Now().UTC(),
+		Employee:    employee,
+		AuditLogs:   logs,
+	}
+	// Logs come back newest first; the histories read chronologically.
+	for i := len(logs) - 1; i >= 0; i-- {
+		log := logs[i]
+		switch log.Operation {
+		case OperationCreateEmployee:
+			if department, ok := log.NewValues["department"]; ok {
+				pkg.DepartmentHistory = append(pkg.DepartmentHistory,
+					FieldChange{Timestamp: log.Timestamp, NewValue: department})
+			}
+			if salary, ok := log.NewValues["salary"]; ok {
+				pkg.SalaryHistory = append(pkg.SalaryHistory,
+					FieldChange{Timestamp: log.Timestamp, NewValue: salary})
+			}
+		case OperationUpdateSalary:
+			pkg.SalaryHistory = append(pkg.SalaryHistory, FieldChange{
+				Timestamp: log.Timestamp,
+				OldValue:  log.OldValues["salary"],
+				NewValue:  log.NewValues["salary"],
+			})
+		case OperationUpdateEmployee:
+			oldDept, oldOK := log.OldValues["department"]
+			newDept, newOK := log.NewValues["department"]
+			if oldOK && newOK && oldDept != newDept {
+				pkg.DepartmentHistory = append(pkg.DepartmentHistory,
+					FieldChange{Timestamp: log.Timestamp, OldValue: oldDept, NewValue: newDept})
+			}
+			oldSalary, oldOK := log.OldValues["salary"]
+			newSalary, newOK := log.NewValues["salary"]
+			if oldOK && newOK && oldSalary != newSalary {
+				pkg.SalaryHistory = append(pkg.SalaryHistory,
+					FieldChange{Timestamp: log.Timestamp, OldValue: oldSalary, NewValue: newSalary})
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// collectEmployeeAuditLogs pages through every audit log referencing the
+// employee, newest first, including logs that predate a deletion.
+func (s *EmployeeService) collectEmployeeAuditLogs(ctx context.Context, id uuid.UUID) ([]*AuditLog, error) {
+	filter := AuditLogFilter{EmployeeID: &id, IncludeDeletedEntities: true}
+	var logs []*AuditLog
+	for offset := 0; ; offset += exportPageSize {
+		page, total, err := s.auditRepo.List(ctx, filter, Pagination{Limit: exportPageSize, Offset: offset})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list audit logs: %w", err)
+		}
+		logs = append(logs, page...)
+		if offset+len(page) >= total || len(page) == 0 {
+			break
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SetUserRepository installs the user lookup used to validate employee↔user
+// account links. Without it LinkEmployeeToUser reports it is not
+// configured.
+func (s *EmployeeService) SetUserRepository(repo UserRepository) {
+	s.userRepo = repo
+}
+
+// LinkEmployeeToUser attaches a user account to an employee record,
+// enabling employeeId authentication and self-service lookups. The user
+// must exist, and both sides must be unlinked: an employee has at most one
+// account and an account at most one employee. Linking the same pair again
+// is a no-op.
+func (s *EmployeeService) LinkEmployeeToUser(ctx context.Context, employeeID, userID uuid.UUID, actorID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	employee, err := s.GetEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.UserID != nil {
+		if *employee.UserID == userID {
+			return employee, nil
+		}
+		return nil, ErrEmployeeAlreadyLinked
+	}
+	if s.userRepo == nil {
+		return nil, fmt.Errorf("user account linking is not configured")
+	}
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	linked, err := s.employeeRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing link: %w", err)
+	}
+	if linked != nil {
+		return nil, ErrUserAlreadyLinked
+	}
+
+	employee.UserID = &userID
+	employee.UpdatedAt = time.Now().UTC()
+	if err := s.employeeRepo.Update(ctx, employee); err != nil {
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &actorID, &employee.ID, OperationLinkEmployee, nil,
+		map[string]any{"userId": userID.String()}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+// GetMyEmployee resolves the employee record linked to the given user
+// account, powering self-service views without exposing the full employee
+// list. It returns ErrNoLinkedEmployee when the account has no link.
+func (s *EmployeeService) GetMyEmployee(ctx context.Context, userID uuid.UUID) (*Employee, error) {
+	employee, err := s.employeeRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find linked employee: %w", err)
+	}
+	if employee == nil {
+		return nil, ErrNoLinkedEmployee
+	}
+	return employee, nil
+}
+
+// selfEditableFields lists the update keys an employee may change on their
+// own record; everything else requires HR or admin access through
+// UpdateEmployee.
+var selfEditableFields = map[string]bool{
+	"phone":   true,
+	"address": true,
+}
+
+// UpdateMyProfile applies updates to the caller's own linked employee
+// record. Only self-editable fields are accepted; any other key is
+// rejected with ErrFieldNotSelfEditable before anything is changed. The
+// update is audited under the acting user like any other employee change.
+func (s *EmployeeService) UpdateMyProfile(ctx context.Context, userID uuid.UUID, updates map[string]any, ipAddress, userAgent string) (*Employee, error) {
+	for field := range updates {
+		if !selfEditableFields[field] {
+			return nil, fmt.Errorf("%w: %s", ErrFieldNotSelfEditable, field)
+		}
+	}
+	employee, err := s.GetMyEmployee(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateEmployee(ctx, employee.ID, updates, userID, ipAddress, userAgent)
+}
+
+// UnlinkEmployee detaches the employee's user account link. Unlinking an
+// employee with no link is a no-op.
+func (s *EmployeeService) UnlinkEmployee(ctx context.Context, employeeID uuid.UUID, actorID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	employee, err := s.GetEmployee(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee.UserID == nil {
+		return employee, nil
+	}
+	previous := employee.UserID.String()
+
+	employee.UserID = nil
+	employee.UpdatedAt = time.Now().UTC()
+	if err := s.employeeRepo.Update(ctx, employee); err != nil {
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &actorID, &employee.ID, OperationUnlinkEmployee,
+		map[string]any{"userId": previous}, nil, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
@@ -0,0 +1,942 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmployeeService implements the employee-facing use cases. It owns
+// business validation, audit logging, and event emission; persistence is
+// delegated to the repository ports.
+type EmployeeService struct {
+	employeeRepo EmployeeRepository
+	userRepo     UserRepository
+	auditRepo    AuditLogRepository
+	eventStore   EventStoreRepository
+	dispatcher   *EventDispatcher
+	geoResolver  GeoIPResolver
+	transactor   Transactor
+
+	managerPolicy   *ManagerDepartmentPolicy
+	departmentRepo  DepartmentRepository
+	positionRepo    PositionRepository
+	enforcePosition bool
+	strictAudit     bool
+	auditAlerter    AuditAlerter
+	alertSeverity   AuditSeverity
+
+	idempotencyWindow time.Duration
+	idempotencyMu     sync.Mutex
+	idempotencyKeys   map[string]idempotencyEntry
+
+	auditSnapshotFields map[string][]string
+	pageSizeLimits      map[UserRole]PageSizeLimits
+}
+
+// defaultIdempotencyWindow is how long a CreateEmployee idempotency key
+// remains valid before a retry is treated as a fresh request.
+const defaultIdempotencyWindow = 24 * time.Hour
+
+// idempotencyEntry remembers which employee a key created and when.
+type idempotencyEntry struct {
+	employeeID uuid.UUID
+	storedAt   time.Time
+}
+
+// SetIdempotencyWindow overrides how long CreateEmployee idempotency keys
+// are honored.
+func (s *EmployeeService) SetIdempotencyWindow(window time.Duration) {
+	if window > 0 {
+		s.idempotencyWindow = window
+	}
+}
+
+// SetStrictAudit controls whether audit-log write failures fail the
+// business operation. Regulated environments enable this so no action can
+// complete unlogged; by default failures are only printed.
+func (s *EmployeeService) SetStrictAudit(strict bool) {
+	s.strictAudit = strict
+}
+
+// SetManagerDepartmentPolicy installs an optional policy requiring managers
+// to share (or be an allowed parent of) their reports' department.
+func (s *EmployeeService) SetManagerDepartmentPolicy(policy *ManagerDepartmentPolicy) {
+	s.managerPolicy = policy
+}
+
+// SetDepartmentRepository installs an optional department catalog. When
+// set, employees referencing a department ID are validated against it and
+// their display name is kept in sync with the canonical department name.
+func (s *EmployeeService) SetDepartmentRepository(repo DepartmentRepository) {
+	s.departmentRepo = repo
+}
+
+// SetPositionRepository installs an optional job-title catalog, enabling
+// the positions listing. Validation against it is a separate switch; see
+// SetEnforcePositionCatalog.
+func (s *EmployeeService) SetPositionRepository(repo PositionRepository) {
+	s.positionRepo = repo
+}
+
+// SetEnforcePositionCatalog toggles validation of employee positions
+// against the catalog. It is off by default so existing free-text position
+// data keeps working.
+func (s *EmployeeService) SetEnforcePositionCatalog(enforce bool) {
+	s.enforcePosition = enforce
+}
+
+// SetAuditSnapshotFields restricts which before/after snapshot fields are
+// captured for the given audit operation, trimming high-volume operations
+// down to the fields that matter (a salary change need only snapshot the
+// salary). Operations without a configured list keep full snapshots, so
+// the default behavior is unchanged.
+func (s *EmployeeService) SetAuditSnapshotFields(operation string, fields ...string) {
+	if s.auditSnapshotFields == nil {
+		s.auditSnapshotFields = make(map[string][]string)
+	}
+	s.auditSnapshotFields[operation] = fields
+}
+
+// SetPageSizeLimits replaces the per-role page-size limits applied to
+// employee listings. Passing nil restores DefaultPageSizeLimits; roles
+// without an entry fall back to the historical 20-default, 100-max clamp.
+func (s *EmployeeService) SetPageSizeLimits(limits map[UserRole]PageSizeLimits) {
+	s.pageSizeLimits = limits
+}
+
+// pageSizeLimitsFor returns the page-size limits for the requester's role.
+func (s *EmployeeService) pageSizeLimitsFor(requester *User) PageSizeLimits {
+	limits := s.pageSizeLimits
+	if limits == nil {
+		limits = DefaultPageSizeLimits()
+	}
+	if requester != nil {
+		if roleLimits, ok := limits[requester.Role]; ok {
+			return roleLimits
+		}
+	}
+	return fallbackPageSizeLimits
+}
+
+// SetGeoIPResolver installs an optional resolver used to stamp audit logs
+// with the geographic origin of the acting IP address.
+func (s *EmployeeService) SetGeoIPResolver(resolver GeoIPResolver) {
+	s.geoResolver = resolver
+}
+
+// SetAuditAlerter installs an alerter notified whenever an audit log at or
+// above minSeverity is recorded.
+func (s *EmployeeService) SetAuditAlerter(alerter AuditAlerter, minSeverity AuditSeverity) {
+	s.auditAlerter = alerter
+	s.alertSeverity = minSeverity
+}
+
+// NewEmployeeService wires an EmployeeService from its dependencies.
+func NewEmployeeService(employeeRepo EmployeeRepository, auditRepo AuditLogRepository, eventStore EventStoreRepository, dispatcher *EventDispatcher) *EmployeeService {
+	return &EmployeeService{
+		employeeRepo:      employeeRepo,
+		auditRepo:         auditRepo,
+		eventStore:        eventStore,
+		dispatcher:        dispatcher,
+		idempotencyWindow: defaultIdempotencyWindow,
+		idempotencyKeys:   make(map[string]idempotencyEntry),
+	}
+}
+
+// CreateEmployee validates and persists a new employee, recording an audit
+// log and emitting EmployeeCreatedEvent. A non-empty idempotencyKey makes
+// retries safe: a repeated key inside the idempotency window returns the
+// originally created employee instead of creating a duplicate.
+func (s *EmployeeService) CreateEmployee(ctx context.Context, employee *Employee, idempotencyKey string, userID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	if idempotencyKey != "" {
+		if id, ok := s.lookupIdempotencyKey(idempotencyKey); ok {
+			return s.GetEmployee(ctx, id)
+		}
+	}
+	if err := s.validateEmployeeCreation(ctx, employee); err != nil {
+		return nil, err
+	}
+	if employee.TenantID == uuid.Nil {
+		employee.TenantID = TenantFrom(ctx)
+	}
+	if err := s.employeeRepo.Create(ctx, employee); err != nil {
+		// The uniqueness pre-check in validateEmployeeCreation races with
+		// concurrent creates; the repository reports the constraint
+		// violation as the same sentinel, so surface it unwrapped.
+		if errors.Is(err, ErrEmailAlreadyExists) {
+			return nil, ErrEmailAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+
+	if idempotencyKey != "" {
+		s.storeIdempotencyKey(idempotencyKey, employee.ID)
+	}
+	if err := s.writeAuditLog(ctx, &userID, &employee.ID, OperationCreateEmployee, nil, createEmployeeSnapshot(employee), ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	s.emitEvent(ctx, NewEmployeeCreatedEvent(employee))
+	return employee, nil
+}
+
+// lookupIdempotencyKey returns the employee created under the key, if the
+// key is still inside its window. Expired entries are pruned as they are
+// encountered.
+func (s *EmployeeService) lookupIdempotencyKey(key string) (uuid.UUID, bool) {
+	s.idempotencyMu.Lock()
+	defer s.idempotencyMu.Unlock()
+	entry, ok := s.idempotencyKeys[key]
+	if !ok {
+		return uuid.Nil, false
+	}
+	if time.Since(entry.storedAt) > s.idempotencyWindow {
+		delete(s.idempotencyKeys, key)
+		return uuid.Nil, false
+	}
+	return entry.employeeID, true
+}
+
+func (s *EmployeeService) storeIdempotencyKey(key string, employeeID uuid.UUID) {
+	s.idempotencyMu.Lock()
+	defer s.idempotencyMu.Unlock()
+	s.idempotencyKeys[key] = idempotencyEntry{employeeID: employeeID, storedAt: time.Now()}
+}
+
+// GetEmployee fetches a single employee by ID.
+func (s *EmployeeService) GetEmployee(ctx context.Context, id uuid.UUID) (*Employee, error) {
+	employee, err := s.employeeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrEmployeeNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, fmt.Errorf("failed to get employee: %w", err)
+	}
+	return employee, nil
+}
+
+// GetEmployeesByIDs batch-fetches employees by ID in a single repository
+// query, the backing fetch for per-request loaders. Missing IDs are
+// silently absent and result order is unspecified.
+func (s *EmployeeService) GetEmployeesByIDs(ctx context.Context, ids []uuid.UUID) ([]*Employee, error) {
+	employees, err := s.employeeRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employees by ids: %w", err)
+	}
+	return employees, nil
+}
+
+// ListEmployees returns a filtered, sorted page of employees.
+func (s *EmployeeService) ListEmployees(ctx context.Context, filter EmployeeFilter, sort EmployeeSort, pagination Pagination) (*EmployeeResult, error) {
+	return s.ListEmployeesFields(ctx, filter, sort, pagination, nil, nil)
+}
+
+// ListEmployeesFields is ListEmployees fetching only the named employee
+// fields, so list views do not pull columns the client will not render.
+// Salary fields are dropped from the projection unless the requester's
+// role may view salaries; a nil fields slice fetches everything. The page
+// size is bounded by the requester's role limits: an unset limit takes
+// the role default and one over the role maximum is rejected with
+// ErrPageSizeTooLarge.
+func (s *EmployeeService) ListEmployeesFields(ctx context.Context, filter EmployeeFilter, sort EmployeeSort, pagination Pagination, fields []string, requester *User) (*EmployeeResult, error) {
+	if fields != nil && (requester == nil || !requester.Role.CanViewSalary()) {
+		filtered := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if field != "salary" && field != "salaryCurrency" {
+				filtered = append(filtered, field)
+			}
+		}
+		fields = filtered
+	}
+	limits := s.pageSizeLimitsFor(requester)
+	if pagination.Limit <= 0 {
+		pagination.Limit = limits.Default
+	}
+	if pagination.Limit > limits.Max {
+		return nil, fmt.Errorf("%w: %d exceeds the maximum of %d", ErrPageSizeTooLarge, pagination.Limit, limits.Max)
+	}
+	result, err := s.employeeRepo.ListFields(ctx, filter, sort, pagination, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employees: %w", err)
+	}
+	return result, nil
+}
+
+// UpdateEmployee applies a map of field updates to an employee, validates
+// the result, persists it, and records the change in the audit log.
+// Updates are applied to a clone and only swapped in once validation
+// passes, so a rejected update never leaves a half-mutated employee.
+func (s *EmployeeService) UpdateEmployee(ctx context.Context, id uuid.UUID, updates map[string]any, userID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	oldSnapshot := createEmployeeSnapshot(employee)
+	updated := employee.Clone()
+	changedFields, err := applyEmployeeUpdates(updated, updates)
+	if err != nil {
+		return nil, err
+	}
+	changedFields = pruneUnchangedFields(employee, updated, changedFields)
+	if len(changedFields) == 0 {
+		// Nothing actually changed: skip the write and the audit entry so
+		// idempotent saves do not spam the log.
+		return employee, nil
+	}
+	if err := s.validateEmployeeUpdate(ctx, updated); err != nil {
+		return nil, err
+	}
+	updated.UpdatedAt = time.Now().UTC()
+	if err := s.employeeRepo.Update(ctx, updated); err != nil {
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+
+	if err := s.writeAuditLog(ctx, &userID, &updated.ID, OperationUpdateEmployee, oldSnapshot, createEmployeeSnapshot(updated), ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	s.emitEvent(ctx, NewEmployeeUpdatedEvent(updated, changedFields))
+	return updated, nil
+}
+
+// ValidateEmployeeUpdate runs an update through the same parsing and
+// business checks as UpdateEmployee without persisting anything, so forms
+// can surface validation errors before submitting. It returns the first
+// validation error, or nil when the update would be accepted.
+func (s *EmployeeService) ValidateEmployeeUpdate(ctx context.Context, id uuid.UUID, updates map[string]any) error {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return err
+	}
+	candidate := employee.Clone()
+	if _, err := applyEmployeeUpdates(candidate, updates); err != nil {
+		return err
+	}
+	return s.validateEmployeeUpdate(ctx, candidate)
+}
+
+// DeleteEmployee removes an employee after checking deletion rules.
+func (s *EmployeeService) DeleteEmployee(ctx context.Context, id uuid.UUID, userID uuid.UUID, ipAddress, userAgent string) error {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.validateEmployeeDeletion(ctx, employee); err != nil {
+		return err
+	}
+	if err := s.employeeRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete employee: %w", err)
+	}
+
+	if err := s.writeAuditLog(ctx, &userID, &id, OperationDeleteEmployee, createEmployeeSnapshot(employee), nil, ipAddress, userAgent); err != nil {
+		return err
+	}
+	s.emitEvent(ctx, NewEmployeeDeletedEvent(id))
+	return nil
+}
+
+// DeleteEmployeeWithReassignment deletes an employee after moving all of
+// their direct reports under a new manager. The new manager must exist and
+// the move must not create a management cycle. One audit entry is recorded
+// per reassigned report, followed by the usual deletion log and event. The
+// reassignment and the deletion commit in one transaction, so a failure
+// cannot leave the reports moved while the employee still exists.
+func (s *EmployeeService) DeleteEmployeeWithReassignment(ctx context.Context, id, newManagerID uuid.UUID, userID uuid.UUID, ipAddress, userAgent string) error {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return err
+	}
+	if newManagerID == id {
+		return ErrCircularManagement
+	}
+	reports, err := s.employeeRepo.ListByManager(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list direct reports: %w", err)
+	}
+	for _, report := range reports {
+		if err := s.validateManager(ctx, report, newManagerID); err != nil {
+			return err
+		}
+	}
+
+	err = s.transact(ctx, func(ctx context.Context) error {
+		if len(reports) > 0 {
+			if err := s.employeeRepo.ReassignManager(ctx, id, newManagerID); err != nil {
+				return err
+			}
+			for _, report := range reports {
+				if err := s.writeAuditLog(ctx, &userID, &report.ID, OperationUpdateEmployee,
+					map[string]any{"managerId": id.String()},
+					map[string]any{"managerId": newManagerID.String()}, ipAddress, userAgent); err != nil {
+					return err
+				}
+			}
+		}
+		if err := s.employeeRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete employee: %w", err)
+		}
+		return s.writeAuditLog(ctx, &userID, &id, OperationDeleteEmployee, createEmployeeSnapshot(employee), nil, ipAddress, userAgent)
+	})
+	if err != nil {
+		return err
+	}
+	s.emitEvent(ctx, NewEmployeeDeletedEvent(id))
+	return nil
+}
+
+// UpdateSalary changes an employee's salary with its own audit operation so
+// compensation changes stand out in the log.
+func (s *EmployeeService) UpdateSalary(ctx context.Context, id uuid.UUID, salary float64, userID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldSalary := employee.Salary
+	if err := employee.UpdateSalary(salary); err != nil {
+		return nil, err
+	}
+	if err := s.employeeRepo.Update(ctx, employee); err != nil {
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+
+	if err := s.writeAuditLog(ctx, &userID, &employee.ID, OperationUpdateSalary,
+		map[string]any{"salary": oldSalary}, map[string]any{"salary": salary}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	s.emitEvent(ctx, NewEmployeeSalaryChangedEvent(employee.ID, oldSalary, salary))
+	return employee, nil
+}
+
+// UpdateEmployeeAddress replaces the employee's address after full address
+// validation, recording an audit log carrying just the address change. A
+// nil address clears the field.
+func (s *EmployeeService) UpdateEmployeeAddress(ctx context.Context, id uuid.UUID, address *Address, userID uuid.UUID, ipAddress, userAgent string) (*Employee, error) {
+	if address != nil {
+		if err := address.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	employee, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldAddress := addressSnapshot(employee.Address)
+	employee.Address = address
+	employee.UpdatedAt = time.Now().UTC()
+	if err := s.employeeRepo.Update(ctx, employee); err != nil {
+		return nil, fmt.Errorf("failed to save employee: %w", err)
+	}
+
+	if err := s.writeAuditLog(ctx, &userID, &employee.ID, OperationUpdateEmployee,
+		map[string]any{"address": oldAddress}, map[string]any{"address": addressSnapshot(address)},
+		ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	s.emitEvent(ctx, NewEmployeeUpdatedEvent(employee, []string{"address"}))
+	return employee, nil
+}
+
+// GetUpcomingAnniversaries lists active employees whose hire-date
+// anniversary falls within the next withinDays days, soonest first. Feb 29
+// hire dates are observed on Mar 1 in non-leap years.
+func (s *EmployeeService) GetUpcomingAnniversaries(ctx context.Context, withinDays int) ([]*Employee, error) {
+	if withinDays <= 0 {
+		return nil, fmt.Errorf("withinDays must be positive")
+	}
+	today := time.Now().Truncate(24 * time.Hour)
+	deadline := today.AddDate(0, 0, withinDays)
+
+	var upcoming []*Employee
+	for offset := 0; ; offset += 100 {
+		result, err := s.employeeRepo.List(ctx, EmployeeFilter{Status: StatusActive},
+			EmployeeSort{Field: "hireDate", Direction: SortAsc}, Pagination{Limit: 100, Offset: offset})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list employees: %w", err)
+		}
+		for _, e := range result.Employees {
+			anniversary := e.NextAnniversary(today)
+			if !anniversary.After(deadline) {
+				upcoming = append(upcoming, e)
+			}
+		}
+		if offset+len(result.Employees) >= result.TotalCount || len(result.Employees) == 0 {
+			break
+		}
+	}
+	sort.Slice(upcoming, func(i, j int) bool {
+		return upcoming[i].NextAnniversary(today).Before(upcoming[j].NextAnniversary(today))
+	})
+	return upcoming, nil
+}
+
+// GetDirectReports lists the employees reporting to the given manager.
+func (s *EmployeeService) GetDirectReports(ctx context.Context, managerID uuid.UUID) ([]*Employee, error) {
+	reports, err := s.employeeRepo.ListByManager(ctx, managerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list direct reports: %w", err)
+	}
+	return reports, nil
+}
+
+// GetReportingChain walks ManagerID links upward from the given employee,
+// returning the chain of managers from immediate to top-level. The walk is
+// capped to guard against cycles introduced by bad data.
+func (s *EmployeeService) GetReportingChain(ctx context.Context, id uuid.UUID) ([]*Employee, error) {
+	const maxDepth = 20
+	var chain []*Employee
+	current, err := s.GetEmployee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; current.ManagerID != nil && i < maxDepth; i++ {
+		manager, err := s.GetEmployee(ctx, *current.ManagerID)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, manager)
+		current = manager
+	}
+	return chain, nil
+}
+
+// GetEmployeeByEmail fetches an employee by email, case-insensitively,
+// returning ErrEmployeeNotFound when no employee matches.
+func (s *EmployeeService) GetEmployeeByEmail(ctx context.Context, email string) (*Employee, error) {
+	employee, err := s.employeeRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find employee by email: %w", err)
+	}
+	if employee == nil {
+		return nil, ErrEmployeeNotFound
+	}
+	return employee, nil
+}
+
+// ExistsByEmail reports whether any employee uses the given email.
+func (s *EmployeeService) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return s.employeeRepo.ExistsByEmail(ctx, email)
+}
+
+// Bounds for SuggestEmployees: prefixes shorter than the minimum are
+// rejected and the result size is hard-capped regardless of the caller's
+// limit.
+const (
+	suggestMinPrefixLen = 2
+	suggestMaxLimit     = 25
+	suggestDefaultLimit = 10
+)
+
+// SuggestEmployees returns a small, name-sorted set of employees whose
+// first or last name starts with the prefix, for type-ahead UIs. It is
+// distinct from the full paginated search: no filters, no paging, just a
+// capped prefix match.
+func (s *EmployeeService) SuggestEmployees(ctx context.Context, prefix string, limit int) ([]*Employee, error) {
+	prefix = strings.TrimSpace(prefix)
+	if len(prefix) < suggestMinPrefixLen {
+		return nil, fmt.Errorf("search prefix must be at least %d characters", suggestMinPrefixLen)
+	}
+	if limit <= 0 {
+		limit = suggestDefaultLimit
+	}
+	if limit > suggestMaxLimit {
+		limit = suggestMaxLimit
+	}
+	return s.employeeRepo.Suggest(ctx, prefix, limit)
+}
+
+// CountEmployees returns the number of employees matching the filter
+// without fetching any rows.
+func (s *EmployeeService) CountEmployees(ctx context.Context, filter EmployeeFilter) (int, error) {
+	return s.employeeRepo.Count(ctx, filter)
+}
+
+// ExistsInDepartment reports whether any employee belongs to the given
+// department, for callers checking headcount before removing a department
+// reference.
+func (s *EmployeeService) ExistsInDepartment(ctx context.Context, department string) (bool, error) {
+	return s.employeeRepo.ExistsInDepartment(ctx, department)
+}
+
+// validateEmployeeCreation runs the business checks that need repository
+// access: email uniqueness and manager existence.
+func (s *EmployeeService) validateEmployeeCreation(ctx context.Context, employee *Employee) error {
+	if err := employee.Validate(); err != nil {
+		return err
+	}
+	exists, err := s.employeeRepo.ExistsByEmail(ctx, employee.Email)
+	if err != nil {
+		return fmt.Errorf("failed to check email uniqueness: %w", err)
+	}
+	if exists {
+		return ErrEmailAlreadyExists
+	}
+	if err := s.validateDepartment(ctx, employee); err != nil {
+		return err
+	}
+	if err := s.validatePosition(ctx, employee); err != nil {
+		return err
+	}
+	if employee.ManagerID != nil {
+		if err := s.validateManager(ctx, employee, *employee.ManagerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEmployeeUpdate re-runs invariants and relationship checks after
+// updates have been applied.
+func (s *EmployeeService) validateEmployeeUpdate(ctx context.Context, employee *Employee) error {
+	if err := employee.Validate(); err != nil {
+		return err
+	}
+	if err := s.validateDepartment(ctx, employee); err != nil {
+		return err
+	}
+	if err := s.validatePosition(ctx, employee); err != nil {
+		return err
+	}
+	if employee.ManagerID != nil {
+		if err := s.validateManager(ctx, employee, *employee.ManagerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDepartment checks the referenced department exists, mirroring the
+// manager existence check, and copies its canonical name onto the employee
+// so the display value cannot drift from the catalog.
+func (s *EmployeeService) validateDepartment(ctx context.Context, employee *Employee) error {
+	if employee.DepartmentID == nil || s.departmentRepo == nil {
+		return nil
+	}
+	department, err := s.departmentRepo.GetByID(ctx, *employee.DepartmentID)
+	if err != nil {
+		if errors.Is(err, ErrDepartmentNotFound) {
+			return ErrDepartmentNotFound
+		}
+		return fmt.Errorf("failed to get department: %w", err)
+	}
+	employee.Department = department.Name
+	return nil
+}
+
+// validateManager checks the manager exists, satisfies the department
+// policy when one is installed, and does not create a cycle in the
+// reporting graph.
+func (s *EmployeeService) validateManager(ctx context.Context, employee *Employee, managerID uuid.UUID) error {
+	employeeID := employee.ID
+	if employeeID == managerID {
+		return ErrCircularManagement
+	}
+	manager, err := s.employeeRepo.GetByID(ctx, managerID)
+	if err != nil {
+		if errors.Is(err, ErrEmployeeNotFound) {
+			return ErrManagerNotFound
+		}
+		return fmt.Errorf("failed to get manager: %w", err)
+	}
+	if s.managerPolicy != nil && !s.managerPolicy.Allows(employee.Department, manager.Department) {
+		return fmt.Errorf("%w: %s cannot manage %s", ErrManagerDepartmentMismatch, manager.Department, employee.Department)
+	}
+	// Walk up from the proposed manager; finding the employee means a cycle.
+	const maxDepth = 20
+	current := manager
+	for i := 0; current.ManagerID != nil && i < maxDepth; i++ {
+		if *current.ManagerID == employeeID {
+			return ErrCircularManagement
+		}
+		next, err := s.employeeRepo.GetByID(ctx, *current.ManagerID)
+		if err != nil {
+			if errors.Is(err, ErrEmployeeNotFound) {
+				// Dangling reference; the chain ends here.
+				break
+			}
+			return fmt.Errorf("failed to get manager: %w", err)
+		}
+		current = next
+	}
+	return nil
+}
+
+// validatePosition checks the employee's position exists in the catalog
+// and copies the canonical title onto the employee. It only runs when a
+// catalog is installed and enforcement is switched on.
+func (s *EmployeeService) validatePosition(ctx context.Context, employee *Employee) error {
+	if s.positionRepo == nil || !s.enforcePosition {
+		return nil
+	}
+	position, err := s.positionRepo.FindByTitle(ctx, employee.Position)
+	if err != nil {
+		return fmt.Errorf("failed to get position: %w", err)
+	}
+	if position == nil {
+		return fmt.Errorf("%w: %s", ErrPositionNotFound, employee.Position)
+	}
+	employee.Position = position.Title
+	return nil
+}
+
+// ListPositions returns the job-title catalog for UI pickers, or nil when
+// no catalog is installed.
+func (s *EmployeeService) ListPositions(ctx context.Context) ([]*Position, error) {
+	if s.positionRepo == nil {
+		return nil, nil
+	}
+	return s.positionRepo.List(ctx)
+}
+
+// validateEmployeeDeletion blocks deleting employees who still have direct
+// reports; they must be reassigned first.
+func (s *EmployeeService) validateEmployeeDeletion(ctx context.Context, employee *Employee) error {
+	reports, err := s.employeeRepo.ListByManager(ctx, employee.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check direct reports: %w", err)
+	}
+	if len(reports) > 0 {
+		return fmt.Errorf("%w: %d direct reports must be reassigned", ErrHasDirectReports, len(reports))
+	}
+	return nil
+}
+
+// pruneUnchangedFields drops update keys whose applied value equals the
+// value already stored, comparing through the audit snapshot so field
+// normalization (trimmed phones, parsed addresses) is accounted for.
+func pruneUnchangedFields(original, updated *Employee, fields []string) []string {
+	oldSnapshot := createEmployeeSnapshot(original)
+	newSnapshot := createEmployeeSnapshot(updated)
+	var changed []string
+	for _, field := range fields {
+		if !reflect.DeepEqual(oldSnapshot[field], newSnapshot[field]) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+// applyEmployeeUpdates copies recognized fields from the update map onto
+// the employee, returning the list of fields that were present. Errors are
+// FieldError values naming the offending field.
+func applyEmployeeUpdates(employee *Employee, updates map[string]any) ([]string, error) {
+	var changedFields []string
+	for field, value := range updates {
+		switch field {
+		case "firstName":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.FirstName = v
+		case "lastName":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.LastName = v
+		case "email":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.Email = v
+		case "phone":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			normalized := normalizePhone(v)
+			if err := validatePhone(normalized); err != nil {
+				return nil, NewFieldError(field, err.Error())
+			}
+			employee.Phone = normalized
+		case "department":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.Department = v
+		case "position":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.Position = v
+		case "status":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employee.Status = EmployeeStatus(v)
+		case "employmentType":
+			v, ok := value.(string)
+			if !ok {
+				return nil, NewFieldError(field, "must be a string")
+			}
+			employmentType := EmploymentType(v)
+			if !employmentType.IsValid() {
+				return nil, NewFieldError(field, fmt.Sprintf("unknown employment type %q", v))
+			}
+			employee.EmploymentType = employmentType
+		case "managerId":
+			switch v := value.(type) {
+			case nil:
+				employee.ManagerID = nil
+			case string:
+				id, err := uuid.Parse(v)
+				if err != nil {
+					return nil, NewFieldError(field, "must be a valid UUID")
+				}
+				employee.ManagerID = &id
+			default:
+				return nil, NewFieldError(field, "must be a UUID string or null")
+			}
+		case "address":
+			addr, err := parseAddress(value)
+			if err != nil {
+				return nil, err
+			}
+			employee.Address = addr
+		default:
+			return nil, NewFieldError(field, "unknown field")
+		}
+		changedFields = append(changedFields, field)
+	}
+	return changedFields, nil
+}
+
+// parseAddress converts a loosely typed map into an Address.
+func parseAddress(value any) (*Address, error) {
+	if value == nil {
+		return nil, nil
+	}
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("address must be an object")
+	}
+	addr := &Address{}
+	if v, ok := m["street"].(string); ok {
+		addr.Street = v
+	}
+	if v, ok := m["city"].(string); ok {
+		addr.City = v
+	}
+	if v, ok := m["state"].(string); ok {
+		addr.State = v
+	}
+	if v, ok := m["postalCode"].(string); ok {
+		addr.PostalCode = v
+	}
+	if v, ok := m["country"].(string); ok {
+		addr.Country = v
+	}
+	return addr, nil
+}
+
+// createEmployeeSnapshot flattens an employee into the map form stored in
+// audit-log old/new value columns.
+func createEmployeeSnapshot(e *Employee) map[string]any {
+	snapshot := map[string]any{
+		"firstName":      e.FirstName,
+		"lastName":       e.LastName,
+		"email":          e.Email,
+		"phone":          e.Phone,
+		"department":     e.Department,
+		"position":       e.Position,
+		"salary":         e.Salary,
+		"hireDate":       e.HireDate.Format(time.RFC3339),
+		"status":         string(e.Status),
+		"employmentType": string(e.EmploymentType),
+	}
+	if e.ManagerID != nil {
+		snapshot["managerId"] = e.ManagerID.String()
+	}
+	if e.Address != nil {
+		snapshot["address"] = addressSnapshot(e.Address)
+	}
+	return snapshot
+}
+
+// addressSnapshot renders an address for audit logging; nil yields nil.
+func addressSnapshot(a *Address) map[string]any {
+	if a == nil {
+		return nil
+	}
+	return map[string]any{
+		"street":     a.Street,
+		"city":       a.City,
+		"state":      a.State,
+		"postalCode": a.PostalCode,
+		"country":    a.Country,
+	}
+}
+
+// writeAuditLog records an audit entry. In strict mode failures are
+// returned so the business operation fails; otherwise they are printed and
+// the operation continues.
+func (s *EmployeeService) writeAuditLog(ctx context.Context, userID, employeeID *uuid.UUID, operation string, oldValues, newValues map[string]any, ipAddress, userAgent string) error {
+	if fields, ok := s.auditSnapshotFields[operation]; ok {
+		oldValues = filterSnapshotFields(oldValues, fields)
+		newValues = filterSnapshotFields(newValues, fields)
+	}
+	log, err := NewAuditLog(userID, employeeID, operation, oldValues, newValues, ipAddress, userAgent)
+	if err != nil {
+		if s.strictAudit {
+			return fmt.Errorf("failed to build audit log: %w", err)
+		}
+		fmt.Printf("failed to build audit log: %v\n", err)
+		return nil
+	}
+	log.Location = resolveLocation(s.geoResolver, ipAddress)
+	log.RequestID = RequestContextFrom(ctx).RequestID
+	log.TenantID = TenantFrom(ctx)
+	if err := s.auditRepo.Create(ctx, log); err != nil {
+		if s.strictAudit {
+			return fmt.Errorf("failed to write audit log: %w", err)
+		}
+		fmt.Printf("failed to write audit log: %v\n", err)
+	}
+	if s.auditAlerter != nil && log.Severity.AtLeast(s.alertSeverity) {
+		s.auditAlerter.Alert(ctx, log)
+	}
+	return nil
+}
+
+// filterSnapshotFields trims a snapshot down to the whitelisted keys. Keys
+// the snapshot does not carry are skipped; an emptied snapshot collapses
+// to nil so the log omits it entirely.
+func filterSnapshotFields(values map[string]any, fields []string) map[string]any {
+	if values == nil {
+		return nil
+	}
+	trimmed := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if v, ok := values[field]; ok {
+			trimmed[field] = v
+		}
+	}
+	if len(trimmed) == 0 {
+		return nil
+	}
+	return trimmed
+}
+
+// emitEvent stores and dispatches a domain event; failures are logged but
+// do not fail the business operation.
+func (s *EmployeeService) emitEvent(ctx context.Context, event DomainEvent) {
+	if s.eventStore != nil {
+		if err := s.eventStore.SaveEvent(ctx, event); err != nil {
+			fmt.Printf("failed to save event: %v\n", err)
+		}
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.Dispatch(ctx, event)
+	}
+}
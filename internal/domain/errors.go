@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Sentinel errors shared across the domain layer. Services return these
+// directly so callers (GraphQL resolvers, CLI commands) can match on them
+// with errors.Is and translate them into user-facing messages.
+var (
+	// Employee errors.
+	ErrEmployeeNotFound   = errors.New("employee not found")
+	ErrEmailAlreadyExists = errors.New("employee email already exists")
+	ErrManagerNotFound    = errors.New("manager not found")
+	ErrCircularManagement = errors.New("circular management relationship")
+
+	ErrManagerDepartmentMismatch = errors.New("manager is in an incompatible department")
+	ErrHasDirectReports          = errors.New("employee has direct reports")
+	ErrInvalidSalary             = errors.New("invalid salary")
+	ErrSalaryTooLow              = fmt.Errorf("%w: below minimum", ErrInvalidSalary)
+	ErrSalaryTooHigh             = fmt.Errorf("%w: above maximum", ErrInvalidSalary)
+	ErrDepartmentNotFound        = errors.New("department not found")
+	ErrPositionNotFound          = errors.New("position not found in catalog")
+	ErrEmployeeAlreadyLinked     = errors.New("employee is already linked to a user account")
+	ErrUserAlreadyLinked         = errors.New("user account is already linked to an employee")
+	ErrNoLinkedEmployee          = errors.New("no employee record is linked to this account")
+	ErrFieldNotSelfEditable      = errors.New("field is not self-editable")
+	ErrBulkUpdateNotConfirmed    = errors.New("filter matches every employee; confirm the bulk update explicitly")
+	ErrPageSizeTooLarge          = errors.New("requested page size exceeds the allowed maximum")
+
+	// User errors.
+	ErrUserNotFound           = errors.New("user not found")
+	ErrUsernameAlreadyExists  = errors.New("username already exists")
+	ErrReservedUsername       = errors.New("username is reserved")
+	ErrInvalidCredentials     = errors.New("invalid credentials")
+	ErrUserInactive           = errors.New("user account is inactive")
+	ErrWeakPassword           = errors.New("password does not meet strength requirements")
+	ErrCommonPassword         = errors.New("password is too common")
+	ErrRateLimited            = errors.New("too many authentication attempts")
+	ErrConcurrentModification = errors.New("concurrent modification detected")
+	ErrCannotDeactivateSelf   = errors.New("cannot deactivate your own account")
+	ErrLastAdmin              = errors.New("cannot deactivate the last active admin")
+
+	// Auth errors.
+	ErrUnknownAuthMethod = errors.New("unknown authentication method")
+	ErrUnauthenticated   = errors.New("unauthenticated")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrInvalidToken      = errors.New("invalid token")
+	ErrTokenExpired      = errors.New("token expired")
+
+	// Audit errors.
+	ErrInvalidOperation = errors.New("invalid audit operation")
+)
+
+// RateLimitedError is returned for rate-limited authentication attempts,
+// carrying how long the caller should wait before retrying. It matches
+// ErrRateLimited under errors.Is, so existing checks keep working while
+// the HTTP layer surfaces the delay as a Retry-After header.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", ErrRateLimited, e.RetryAfter.Round(time.Second))
+}
+
+// Unwrap lets errors.Is(err, ErrRateLimited) match.
+func (e *RateLimitedError) Unwrap() error { return ErrRateLimited }
+
+// FieldError reports a validation failure tied to a specific input field,
+// so the GraphQL layer can surface the failing field to clients.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// NewFieldError builds a FieldError for the given field.
+func NewFieldError(field, message string) *FieldError {
+	return &FieldError{Field: field, Message: message}
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
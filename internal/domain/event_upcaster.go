@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EventUpcaster transforms a stored event payload from one schema version
+// to the next, so old events written before a shape change stay
+// replayable. The returned payload must carry the bumped version number.
+type EventUpcaster func(payload []byte) ([]byte, error)
+
+// eventUpcasters maps event type and source version to the upcaster that
+// lifts payloads of that version one step forward.
+var eventUpcasters = map[string]map[int]EventUpcaster{}
+
+// RegisterEventUpcaster registers a transform from fromVersion of the
+// given event type to the next version. Registering the same step twice
+// fails so conflicting migrations surface at startup. It is intended for
+// startup configuration, not concurrent use.
+func RegisterEventUpcaster(eventType string, fromVersion int, upcaster EventUpcaster) error {
+	if eventType == "" {
+		return fmt.Errorf("event type is required")
+	}
+	if fromVersion < 1 {
+		return fmt.Errorf("event version must be at least 1")
+	}
+	steps := eventUpcasters[eventType]
+	if steps == nil {
+		steps = make(map[int]EventUpcaster)
+		eventUpcasters[eventType] = steps
+	}
+	if _, exists := steps[fromVersion]; exists {
+		return fmt.Errorf("upcaster already registered for %s v%d", eventType, fromVersion)
+	}
+	steps[fromVersion] = upcaster
+	return nil
+}
+
+// UpcastStoredEvent applies registered upcasters to the event's payload
+// until it reaches a version with no further step, mutating the payload in
+// place. Events with no registered upcasters pass through unchanged.
+func UpcastStoredEvent(e *StoredEvent) error {
+	for {
+		version := payloadVersion(e.Payload)
+		upcaster := eventUpcasters[e.EventType][version]
+		if upcaster == nil {
+			return nil
+		}
+		payload, err := upcaster(e.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to upcast %s v%d: %w", e.EventType, version, err)
+		}
+		if payloadVersion(payload) <= version {
+			return fmt.Errorf("upcaster for %s v%d did not advance the version", e.EventType, version)
+		}
+		e.Payload = payload
+	}
+}
+
+// payloadVersion reads the schema version from a serialized event payload.
+// Events written before versioning carry no field and count as version 1.
+func payloadVersion(payload []byte) int {
+	var envelope struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(payload, &envelope); err != nil || envelope.Version < 1 {
+		return 1
+	}
+	return envelope.Version
+}
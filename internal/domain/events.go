@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DomainEvent is implemented by all events emitted from the domain layer.
+type DomainEvent interface {
+	EventType() string
+	AggregateID() uuid.UUID
+	OccurredAt() time.Time
+	EventVersion() int
+}
+
+// StoredEvent is a persisted domain event as read back from an event
+// store: the serialized payload plus the store-assigned monotonically
+// increasing sequence number that fixes the global replay order.
+type StoredEvent struct {
+	ID          uuid.UUID `json:"id"`
+	AggregateID uuid.UUID `json:"aggregateId"`
+	EventType   string    `json:"eventType"`
+	Payload     []byte    `json:"payload"`
+	Sequence    int64     `json:"sequence"`
+	OccurredAt  time.Time `json:"occurredAt"`
+}
+
+// BaseEvent carries the fields common to every domain event and is embedded
+// by the concrete event types.
+type BaseEvent struct {
+	Type      string    `json:"type"`
+	Aggregate uuid.UUID `json:"aggregateId"`
+	Occurred  time.Time `json:"occurredAt"`
+	// Version is the event's schema version; upcasters lift older stored
+	// payloads forward during replay. See event_upcaster.go.
+	Version int `json:"version"`
+}
+
+func (e BaseEvent) EventType() string      { return e.Type }
+func (e BaseEvent) AggregateID() uuid.UUID { return e.Aggregate }
+func (e BaseEvent) OccurredAt() time.Time  { return e.Occurred }
+func (e BaseEvent) EventVersion() int      { return e.Version }
+
+func newBaseEvent(eventType string, aggregateID uuid.UUID) BaseEvent {
+	return BaseEvent{Type: eventType, Aggregate: aggregateID, Occurred: time.Now().UTC(), Version: 1}
+}
+
+// EmployeeCreatedEvent fires after a new employee is persisted.
+type EmployeeCreatedEvent struct {
+	BaseEvent
+	Employee *Employee `json:"employee"`
+}
+
+// NewEmployeeCreatedEvent builds the event for a freshly created employee.
+func NewEmployeeCreatedEvent(e *Employee) *EmployeeCreatedEvent {
+	return &EmployeeCreatedEvent{BaseEvent: newBaseEvent("EmployeeCreated", e.ID), Employee: e}
+}
+
+// EmployeeUpdatedEvent fires after an employee update is persisted,
+// carrying the set of fields that changed.
+type EmployeeUpdatedEvent struct {
+	BaseEvent
+	Employee      *Employee `json:"employee"`
+	ChangedFields []string  `json:"changedFields"`
+}
+
+// NewEmployeeUpdatedEvent builds the event for a persisted employee update.
+func NewEmployeeUpdatedEvent(e *Employee, changedFields []string) *EmployeeUpdatedEvent {
+	return &EmployeeUpdatedEvent{BaseEvent: newBaseEvent("EmployeeUpdated", e.ID), Employee: e, ChangedFields: changedFields}
+}
+
+// EmployeeDeletedEvent fires after an employee is removed.
+type EmployeeDeletedEvent struct {
+	BaseEvent
+}
+
+// NewEmployeeDeletedEvent builds the event for a deleted employee.
+func NewEmployeeDeletedEvent(id uuid.UUID) *EmployeeDeletedEvent {
+	return &EmployeeDeletedEvent{BaseEvent: newBaseEvent("EmployeeDeleted", id)}
+}
+
+// EmployeeSalaryChangedEvent fires when an employee's salary changes.
+type EmployeeSalaryChangedEvent struct {
+	BaseEvent
+	OldSalary float64 `json:"oldSalary"`
+	NewSalary float64 `json:"newSalary"`
+}
+
+// NewEmployeeSalaryChangedEvent builds the event for a salary change.
+func NewEmployeeSalaryChangedEvent(id uuid.UUID, oldSalary, newSalary float64) *EmployeeSalaryChangedEvent {
+	return &EmployeeSalaryChangedEvent{BaseEvent: newBaseEvent("EmployeeSalaryChanged", id), OldSalary: oldSalary, NewSalary: newSalary}
+}
+
+// UserLoggedInEvent fires after a successful authentication.
+type UserLoggedInEvent struct {
+	BaseEvent
+	Username  string `json:"username"`
+	IPAddress string `json:"ipAddress"`
+}
+
+// NewUserLoggedInEvent builds the event for a successful login.
+func NewUserLoggedInEvent(u *User, ipAddress string) *UserLoggedInEvent {
+	return &UserLoggedInEvent{BaseEvent: newBaseEvent("UserLoggedIn", u.ID), Username: u.Username, IPAddress: ipAddress}
+}
+
+// Login failure reasons carried by LoginFailedEvent and the LOGIN_FAILED
+// audit log.
+const (
+	LoginFailureInvalidCredentials = "INVALID_CREDENTIALS"
+	LoginFailureInactive           = "ACCOUNT_INACTIVE"
+	LoginFailureRateLimited        = "RATE_LIMITED"
+)
+
+// LoginFailedEvent fires after a failed authentication attempt so security
+// tooling can watch for brute-force patterns.
+type LoginFailedEvent struct {
+	BaseEvent
+	Username  string `json:"username"`
+	IPAddress string `json:"ipAddress"`
+	Reason    string `json:"reason"`
+}
+
+// NewLoginFailedEvent builds the event for a failed login attempt; pass
+// uuid.Nil as userID when the identifier did not resolve to an account.
+func NewLoginFailedEvent(userID uuid.UUID, username, ipAddress, reason string) *LoginFailedEvent {
+	return &LoginFailedEvent{BaseEvent: newBaseEvent("LoginFailed", userID), Username: username, IPAddress: ipAddress, Reason: reason}
+}
+
+// PasswordResetRequestedEvent fires when a password reset is initiated for
+// an existing account. A notification handler is expected to deliver the
+// token to the user's email.
+type PasswordResetRequestedEvent struct {
+	BaseEvent
+	Email string `json:"email"`
+	Token string `json:"token"`
+}
+
+// NewPasswordResetRequestedEvent builds the event for a reset request.
+func NewPasswordResetRequestedEvent(u *User, token string) *PasswordResetRequestedEvent {
+	return &PasswordResetRequestedEvent{BaseEvent: newBaseEvent("PasswordResetRequested", u.ID), Email: u.Email, Token: token}
+}
+
+// SuspiciousLoginDetectedEvent fires when a successful login looks anomalous,
+// such as originating from a country the user has never logged in from.
+type SuspiciousLoginDetectedEvent struct {
+	BaseEvent
+	Username  string       `json:"username"`
+	IPAddress string       `json:"ipAddress"`
+	Location  *GeoLocation `json:"location,omitempty"`
+	Reason    string       `json:"reason"`
+}
+
+// NewSuspiciousLoginDetectedEvent builds the event for a flagged login.
+func NewSuspiciousLoginDetectedEvent(u *User, ipAddress string, location *GeoLocation, reason string) *SuspiciousLoginDetectedEvent {
+	return &SuspiciousLoginDetectedEvent{
+		BaseEvent: newBaseEvent("SuspiciousLoginDetected", u.ID),
+		Username:  u.Username,
+		IPAddress: ipAddress,
+		Location:  location,
+		Reason:    reason,
+	}
+}
+
+// EventHandler processes a single dispatched event. Handlers must be safe
+// to call concurrently.
+type EventHandler func(ctx context.Context, event DomainEvent)
+
+// EventDispatcher fans domain events out to registered handlers. By
+// default Dispatch is synchronous: handlers run in registration order on
+// the caller's goroutine. StartAsync switches delivery to a bounded worker
+// pool so slow handlers (webhooks) do not block the request; see
+// events_async.go.
+type EventDispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+	queue    chan queuedEvent
+	workers  sync.WaitGroup
+}
+
+// NewEventDispatcher creates an empty dispatcher.
+func NewEventDispatcher() *EventDispatcher {
+	return &EventDispatcher{handlers: make(map[string][]EventHandler)}
+}
+
+// Subscribe registers a handler for the given event type. The empty string
+// subscribes to all events.
+func (d *EventDispatcher) Subscribe(eventType string, handler EventHandler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[eventType] = append(d.handlers[eventType], handler)
+}
+
+// Dispatch delivers the event to handlers subscribed to its type and to
+// wildcard subscribers. In async mode it enqueues and returns; the
+// handlers run on the worker pool.
+func (d *EventDispatcher) Dispatch(ctx context.Context, event DomainEvent) {
+	d.mu.RLock()
+	if d.queue != nil {
+		// The send happens under the read lock so it is ordered before a
+		// concurrent Drain closes the queue. Context values (tenant,
+		// request metadata) survive the request, but its cancellation must
+		// not kill a handler mid-flight.
+		d.queue <- queuedEvent{ctx: context.WithoutCancel(ctx), event: event}
+		d.mu.RUnlock()
+		return
+	}
+	d.mu.RUnlock()
+	d.deliver(ctx, event)
+}
+
+// deliver runs the subscribed and wildcard handlers in registration order.
+func (d *EventDispatcher) deliver(ctx context.Context, event DomainEvent) {
+	d.mu.RLock()
+	handlers := append([]EventHandler{}, d.handlers[event.EventType()]...)
+	handlers = append(handlers, d.handlers[""]...)
+	d.mu.RUnlock()
+	for _, h := range handlers {
+		h(ctx, event)
+	}
+}
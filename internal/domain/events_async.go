@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// Default sizing for asynchronous event delivery. The queue bounds how far
+// dispatchers can run ahead of slow handlers before Dispatch applies
+// backpressure.
+const (
+	DefaultEventWorkers   = 4
+	DefaultEventQueueSize = 256
+)
+
+// queuedEvent is one dispatched event waiting for a worker.
+type queuedEvent struct {
+	ctx   context.Context
+	event DomainEvent
+}
+
+// StartAsync switches the dispatcher to asynchronous delivery: Dispatch
+// enqueues and returns immediately while a pool of workers goroutines runs
+// the handlers, blocking only when the queue of queueSize events is full.
+// Non-positive arguments use the defaults. Starting an already-async
+// dispatcher is a no-op; without StartAsync delivery stays synchronous,
+// which tests rely on.
+func (d *EventDispatcher) StartAsync(workers, queueSize int) {
+	if workers <= 0 {
+		workers = DefaultEventWorkers
+	}
+	if queueSize <= 0 {
+		queueSize = DefaultEventQueueSize
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.queue != nil {
+		return
+	}
+	queue := make(chan queuedEvent, queueSize)
+	d.queue = queue
+	for i := 0; i < workers; i++ {
+		d.workers.Add(1)
+		go func() {
+			defer d.workers.Done()
+			for qe := range queue {
+				d.deliver(qe.ctx, qe.event)
+			}
+		}()
+	}
+}
+
+// QueueDepth reports how many dispatched events are waiting for a worker,
+// for queue-pressure metrics. It is always zero in synchronous mode.
+func (d *EventDispatcher) QueueDepth() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.queue == nil {
+		return 0
+	}
+	return len(d.queue)
+}
+
+// Drain switches the dispatcher back to synchronous mode and blocks until
+// every queued event has been handled, bounded by the context. It is the
+// shutdown counterpart of StartAsync; events dispatched afterwards are
+// delivered synchronously rather than dropped.
+func (d *EventDispatcher) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	queue := d.queue
+	d.queue = nil
+	d.mu.Unlock()
+	if queue == nil {
+		return nil
+	}
+	close(queue)
+
+	done := make(chan struct{})
+	go func() {
+		d.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to drain event queue: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+// GeoLocation is the resolved geographic origin of an IP address. Latitude
+// and Longitude are zero when the resolver only knows the country or city.
+type GeoLocation struct {
+	Country   string  `json:"country"`
+	City      string  `json:"city"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+// GeoIPResolver maps an IP address to its geographic location. Implementations
+// typically wrap a local database such as MaxMind GeoLite2. Resolve returns
+// (nil, nil) when the address is unknown (private ranges, empty input).
+type GeoIPResolver interface {
+	Resolve(ip string) (*GeoLocation, error)
+}
+
+// resolveLocation resolves an IP through an optional resolver, degrading to
+// no location when the resolver is absent or fails.
+func resolveLocation(resolver GeoIPResolver, ip string) *GeoLocation {
+	if resolver == nil || ip == "" {
+		return nil
+	}
+	location, err := resolver.Resolve(ip)
+	if err != nil {
+		return nil
+	}
+	return location
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginDetectorConfig tunes the suspicious-login heuristics.
+type LoginDetectorConfig struct {
+	// MaxTravelSpeedKmh is the fastest plausible travel speed between two
+	// consecutive logins; faster implies the credentials are in two places.
+	MaxTravelSpeedKmh float64
+	// FlagNewCountry flags logins from a country the user has never logged
+	// in from before.
+	FlagNewCountry bool
+}
+
+// DefaultLoginDetectorConfig allows commercial-flight speeds and flags
+// first-time countries.
+func DefaultLoginDetectorConfig() LoginDetectorConfig {
+	return LoginDetectorConfig{MaxTravelSpeedKmh: 900, FlagNewCountry: true}
+}
+
+// loginObservation is what the detector remembers about a user's last login.
+type loginObservation struct {
+	location  *GeoLocation
+	seenAt    time.Time
+	countries map[string]struct{}
+}
+
+// LoginDetector flags anomalous logins: first-time countries and
+// impossible-travel scenarios where consecutive logins are further apart
+// than any traveller could cover. State is held in memory per user.
+type LoginDetector struct {
+	config LoginDetectorConfig
+
+	mu       sync.Mutex
+	lastSeen map[uuid.UUID]*loginObservation
+}
+
+// NewLoginDetector creates a detector with the given thresholds.
+func NewLoginDetector(config LoginDetectorConfig) *LoginDetector {
+	if config.MaxTravelSpeedKmh <= 0 {
+		config.MaxTravelSpeedKmh = DefaultLoginDetectorConfig().MaxTravelSpeedKmh
+	}
+	return &LoginDetector{config: config, lastSeen: make(map[uuid.UUID]*loginObservation)}
+}
+
+// Check records a successful login and reports whether it looks suspicious,
+// returning a human-readable reason when it does. Logins without a resolved
+// location are recorded but never flagged.
+func (d *LoginDetector) Check(userID uuid.UUID, location *GeoLocation, at time.Time) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	previous := d.lastSeen[userID]
+	reason := ""
+	if location != nil && previous != nil {
+		if d.config.FlagNewCountry && location.Country != "" {
+			if _, known := previous.countries[location.Country]; !known {
+				reason = fmt.Sprintf("login from new country %s", location.Country)
+			}
+		}
+		if reason == "" && previous.location != nil {
+			if speed, ok := travelSpeedKmh(previous.location, location, at.Sub(previous.seenAt)); ok && speed > d.config.MaxTravelSpeedKmh {
+				reason = fmt.Sprintf("impossible travel at %.0f km/h since previous login", speed)
+			}
+		}
+	}
+
+	d.record(userID, location, at)
+	return reason, reason != ""
+}
+
+// record updates the per-user observation under the held lock.
+func (d *LoginDetector) record(userID uuid.UUID, location *GeoLocation, at time.Time) {
+	obs := d.lastSeen[userID]
+	if obs == nil {
+		obs = &loginObservation{countries: make(map[string]struct{})}
+		d.lastSeen[userID] = obs
+	}
+	obs.seenAt = at
+	if location != nil {
+		obs.location = location
+		if location.Country != "" {
+			obs.countries[location.Country] = struct{}{}
+		}
+	}
+}
+
+// travelSpeedKmh computes the implied travel speed between two locations.
+// It reports ok=false when either location lacks coordinates or the elapsed
+// time is not positive.
+func travelSpeedKmh(from, to *GeoLocation, elapsed time.Duration) (float64, bool) {
+	if elapsed <= 0 {
+		return 0, false
+	}
+	if (from.Latitude == 0 && from.Longitude == 0) || (to.Latitude == 0 && to.Longitude == 0) {
+		return 0, false
+	}
+	distance := haversineKm(from.Latitude, from.Longitude, to.Latitude, to.Longitude)
+	return distance / elapsed.Hours(), true
+}
+
+// haversineKm is the great-circle distance between two coordinates.
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadiusKm = 6371
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
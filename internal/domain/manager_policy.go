@@ -0,0 +1,26 @@
+package domain
+
+// ManagerDepartmentPolicy restricts which departments a manager may manage
+// reports in. When installed on the EmployeeService, manager assignments
+// are rejected with ErrManagerDepartmentMismatch unless the manager shares
+// the report's department or belongs to one of its allowed parents.
+type ManagerDepartmentPolicy struct {
+	// AllowedParents lists, per department, additional departments whose
+	// members may manage it (e.g. "Engineering" reports managed from the
+	// "CTO Office").
+	AllowedParents map[string][]string
+}
+
+// Allows reports whether a manager in managerDepartment may manage a report
+// in employeeDepartment.
+func (p *ManagerDepartmentPolicy) Allows(employeeDepartment, managerDepartment string) bool {
+	if managerDepartment == employeeDepartment {
+		return true
+	}
+	for _, parent := range p.AllowedParents[employeeDepartment] {
+		if parent == managerDepartment {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ManagerRepairStrategy selects how RepairManagerReferences fixes employees
+// whose manager no longer exists.
+type ManagerRepairStrategy string
+
+const (
+	// RepairStrategyNullify clears the dangling manager reference.
+	RepairStrategyNullify ManagerRepairStrategy = "NULLIFY"
+	// RepairStrategyReassign points the dangling reference at a
+	// caller-supplied replacement manager.
+	RepairStrategyReassign ManagerRepairStrategy = "REASSIGN"
+)
+
+// FindOrphanedManagerReferences returns employees whose ManagerID points at
+// an employee that no longer exists. It is a maintenance scan; deletions
+// normally reassign reports, but historical data and manual database edits
+// can leave dangling references behind.
+func (s *EmployeeService) FindOrphanedManagerReferences(ctx context.Context) ([]*Employee, error) {
+	employees, err := s.listAllEmployees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[uuid.UUID]struct{}, len(employees))
+	for _, e := range employees {
+		ids[e.ID] = struct{}{}
+	}
+	var orphaned []*Employee
+	for _, e := range employees {
+		if e.ManagerID == nil {
+			continue
+		}
+		if _, ok := ids[*e.ManagerID]; !ok {
+			orphaned = append(orphaned, e)
+		}
+	}
+	return orphaned, nil
+}
+
+// RepairManagerReferences fixes every orphaned manager reference using the
+// given strategy and returns how many employees were updated. The
+// REASSIGN strategy requires a newManagerID that resolves to an existing
+// employee; NULLIFY ignores it.
+func (s *EmployeeService) RepairManagerReferences(ctx context.Context, strategy ManagerRepairStrategy, newManagerID *uuid.UUID) (int, error) {
+	var replacement *uuid.UUID
+	switch strategy {
+	case RepairStrategyNullify:
+	case RepairStrategyReassign:
+		if newManagerID == nil {
+			return 0, fmt.Errorf("reassign strategy requires a new manager id")
+		}
+		if _, err := s.employeeRepo.GetByID(ctx, *newManagerID); err != nil {
+			if errors.Is(err, ErrEmployeeNotFound) {
+				return 0, ErrManagerNotFound
+			}
+			return 0, fmt.Errorf("failed to get manager: %w", err)
+		}
+		replacement = newManagerID
+	default:
+		return 0, fmt.Errorf("unknown repair strategy: %s", strategy)
+	}
+
+	orphaned, err := s.FindOrphanedManagerReferences(ctx)
+	if err != nil {
+		return 0, err
+	}
+	repaired := 0
+	for _, employee := range orphaned {
+		if replacement != nil && *replacement == employee.ID {
+			// Never point an employee at themselves; leave it for a
+			// follow-up run with a different replacement.
+			continue
+		}
+		employee.ManagerID = replacement
+		employee.UpdatedAt = time.Now().UTC()
+		if err := s.employeeRepo.Update(ctx, employee); err != nil {
+			return repaired, fmt.Errorf("failed to repair employee %s: %w", employee.ID, err)
+		}
+		repaired++
+	}
+	return repaired, nil
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// NameRules describes how person names are validated. Deployments can swap
+// the configured rules via SetNameRules; the defaults keep the bounds the
+// service has always enforced while accepting hyphenated names like
+// "Doe-Smith" and apostrophes as in "O'Brien".
+type NameRules struct {
+	// MinLength and MaxLength bound the name length in bytes.
+	MinLength int
+	MaxLength int
+	// AllowUnicodeLetters accepts any unicode letter; when false only
+	// ASCII letters pass.
+	AllowUnicodeLetters bool
+	// AllowHyphens and AllowApostrophes accept '-' and '\'' inside names.
+	AllowHyphens     bool
+	AllowApostrophes bool
+}
+
+// DefaultNameRules returns the default validation rules: 2-50 characters,
+// unicode letters, spaces, hyphens, and apostrophes.
+func DefaultNameRules() *NameRules {
+	return &NameRules{
+		MinLength:           2,
+		MaxLength:           50,
+		AllowUnicodeLetters: true,
+		AllowHyphens:        true,
+		AllowApostrophes:    true,
+	}
+}
+
+// Validate checks the name against the rules, naming the offending field
+// in the returned error.
+func (r *NameRules) Validate(field, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if len(name) < r.MinLength {
+		return fmt.Errorf("%s must be at least %d characters", field, r.MinLength)
+	}
+	if r.MaxLength > 0 && len(name) > r.MaxLength {
+		return fmt.Errorf("%s must be at most %d characters", field, r.MaxLength)
+	}
+	for _, c := range name {
+		if r.allowed(c) {
+			continue
+		}
+		return fmt.Errorf("%s contains invalid characters", field)
+	}
+	return nil
+}
+
+// allowed reports whether a single rune may appear in a name.
+func (r *NameRules) allowed(c rune) bool {
+	switch {
+	case c == ' ':
+		return true
+	case c == '-':
+		return r.AllowHyphens
+	case c == '\'':
+		return r.AllowApostrophes
+	case r.AllowUnicodeLetters:
+		return unicode.IsLetter(c)
+	default:
+		return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	}
+}
+
+// NormalizeName trims the name and collapses runs of internal whitespace
+// to single spaces, so " john  DOE " stores as "john DOE". Casing is left
+// alone to preserve the original for matching; use TitleCaseName for
+// display.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// TitleCaseName normalizes the name and upper-cases the first letter of
+// each part — after a space, hyphen, or apostrophe — lowering the rest, so
+// "john DOE-smith" renders as "John Doe-Smith". It is unicode-aware.
+func TitleCaseName(name string) string {
+	var b strings.Builder
+	boundary := true
+	for _, c := range NormalizeName(name) {
+		if boundary {
+			b.WriteRune(unicode.ToUpper(c))
+		} else {
+			b.WriteRune(unicode.ToLower(c))
+		}
+		boundary = c == ' ' || c == '-' || c == '\''
+	}
+	return b.String()
+}
+
+// nameRules are the rules consulted by validateName.
+var nameRules = DefaultNameRules()
+
+// SetNameRules replaces the configured name validation rules. Passing nil
+// restores the default. It is intended for startup configuration, not
+// concurrent use.
+func SetNameRules(rules *NameRules) {
+	if rules == nil {
+		rules = DefaultNameRules()
+	}
+	nameRules = rules
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// Notifier delivers a notification for a domain event, for example an
+// email when an employee is created or terminated. Implementations decide
+// the channel, templates, and recipients; returning an error only reports
+// the failure, it never fails the operation that emitted the event.
+type Notifier interface {
+	Notify(ctx context.Context, event DomainEvent) error
+}
+
+// SubscribeNotifier registers the notifier with the dispatcher for the
+// given event types, or for every event when none are given. Delivery
+// failures are printed; notifications are best-effort by design.
+func SubscribeNotifier(dispatcher *EventDispatcher, notifier Notifier, eventTypes ...string) {
+	handler := func(ctx context.Context, event DomainEvent) {
+		if err := notifier.Notify(ctx, event); err != nil {
+			fmt.Printf("failed to send notification for %s: %v\n", event.EventType(), err)
+		}
+	}
+	if len(eventTypes) == 0 {
+		dispatcher.Subscribe("", handler)
+		return
+	}
+	for _, eventType := range eventTypes {
+		dispatcher.Subscribe(eventType, handler)
+	}
+}
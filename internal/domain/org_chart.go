@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// orgChartMaxDepth caps how deep the org chart recurses, mirroring the
+// reporting-chain walk limit.
+const orgChartMaxDepth = 20
+
+// OrgNode is one employee in the org chart with their transitive reports.
+type OrgNode struct {
+	Employee *Employee  `json:"employee"`
+	Children []*OrgNode `json:"children"`
+}
+
+// BuildOrgChart returns the reporting tree rooted at the given employee.
+// When rootID is nil the returned node has a nil Employee and its children
+// are all top-level managers. The whole employee set is fetched in one
+// paged pass rather than per-node queries, and depth is capped to keep
+// pathological graphs from recursing forever.
+func (s *EmployeeService) BuildOrgChart(ctx context.Context, rootID *uuid.UUID) (*OrgNode, error) {
+	employees, err := s.listAllEmployees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byManager := make(map[uuid.UUID][]*Employee)
+	byID := make(map[uuid.UUID]*Employee, len(employees))
+	for _, e := range employees {
+		byID[e.ID] = e
+		if e.ManagerID != nil {
+			byManager[*e.ManagerID] = append(byManager[*e.ManagerID], e)
+		}
+	}
+
+	root := &OrgNode{}
+	if rootID != nil {
+		employee, ok := byID[*rootID]
+		if !ok {
+			return nil, ErrEmployeeNotFound
+		}
+		root.Employee = employee
+		root.Children = buildOrgChildren(employee.ID, byManager, orgChartMaxDepth)
+		return root, nil
+	}
+	for _, e := range employees {
+		if e.ManagerID == nil {
+			root.Children = append(root.Children, &OrgNode{
+				Employee: e,
+				Children: buildOrgChildren(e.ID, byManager, orgChartMaxDepth),
+			})
+		}
+	}
+	return root, nil
+}
+
+// buildOrgChildren recursively attaches reports until the depth budget is
+// spent.
+func buildOrgChildren(managerID uuid.UUID, byManager map[uuid.UUID][]*Employee, depth int) []*OrgNode {
+	if depth <= 0 {
+		return nil
+	}
+	var children []*OrgNode
+	for _, report := range byManager[managerID] {
+		children = append(children, &OrgNode{
+			Employee: report,
+			Children: buildOrgChildren(report.ID, byManager, depth-1),
+		})
+	}
+	return children
+}
+
+// listAllEmployees streams the full employee set into memory.
+func (s *EmployeeService) listAllEmployees(ctx context.Context) ([]*Employee, error) {
+	return s.collectEmployees(ctx, EmployeeFilter{})
+}
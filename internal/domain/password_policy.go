@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// PasswordPolicy describes the password strength rules enforced when
+// passwords are created or changed. Deployments can swap the configured
+// policy via SetPasswordPolicy; the default matches the rules the service
+// has always enforced.
+type PasswordPolicy struct {
+	// MinLength is the minimum password length.
+	MinLength int
+	// MaxLength caps password length; zero means no cap.
+	MaxLength int
+	// RequireUpper, RequireLower, RequireDigit, and RequireSpecial demand
+	// at least one character of the corresponding class.
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSpecial bool
+	// denylist holds normalized forms of known-breached passwords; see
+	// SetDenylist.
+	denylist map[string]struct{}
+}
+
+// DefaultPasswordPolicy returns the historical rules: at least 8
+// characters with upper, lower, digit, and special characters present.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:      8,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+	}
+}
+
+// Validate checks the password against the policy, returning an error
+// wrapping ErrWeakPassword on the first violated rule.
+func (p *PasswordPolicy) Validate(password string) error {
+	if len(password) < p.MinLength {
+		return fmt.Errorf("%w: must be at least %d characters", ErrWeakPassword, p.MinLength)
+	}
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		return fmt.Errorf("%w: must be at most %d characters", ErrWeakPassword, p.MaxLength)
+	}
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		return fmt.Errorf("%w: must contain an uppercase letter", ErrWeakPassword)
+	}
+	if p.RequireLower && !hasLower {
+		return fmt.Errorf("%w: must contain a lowercase letter", ErrWeakPassword)
+	}
+	if p.RequireDigit && !hasDigit {
+		return fmt.Errorf("%w: must contain a digit", ErrWeakPassword)
+	}
+	if p.RequireSpecial && !hasSpecial {
+		return fmt.Errorf("%w: must contain a special character", ErrWeakPassword)
+	}
+	if p.denylist != nil {
+		if _, found := p.denylist[normalizePassword(password)]; found {
+			return ErrCommonPassword
+		}
+	}
+	return nil
+}
+
+// SetDenylist installs a list of known-breached passwords the policy must
+// reject. Matching is case-insensitive and tolerant of simple leetspeak
+// substitutions, so "Password1!" is caught by a "password" entry only when
+// the full normalized forms match.
+func (p *PasswordPolicy) SetDenylist(passwords []string) {
+	p.denylist = make(map[string]struct{}, len(passwords))
+	for _, password := range passwords {
+		p.denylist[normalizePassword(password)] = struct{}{}
+	}
+}
+
+// LoadDenylist reads a newline-separated password list (such as a
+// top-breached-passwords file) into the policy denylist. Blank lines and
+// lines starting with '#' are skipped.
+func (p *PasswordPolicy) LoadDenylist(r io.Reader) error {
+	var passwords []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read password denylist: %w", err)
+	}
+	p.SetDenylist(passwords)
+	return nil
+}
+
+// leetSubstitutions maps common character swaps back to their letters so
+// obvious variants of denied passwords are also rejected.
+var leetSubstitutions = map[rune]rune{
+	'0': 'o',
+	'1': 'l',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+}
+
+// normalizePassword lowercases the password and undoes simple leetspeak
+// substitutions for denylist comparison.
+func normalizePassword(password string) string {
+	var b strings.Builder
+	b.Grow(len(password))
+	for _, r := range strings.ToLower(password) {
+		if sub, ok := leetSubstitutions[r]; ok {
+			r = sub
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// passwordPolicy is the policy consulted by HashPassword and
+// validatePasswordStrength.
+var passwordPolicy = DefaultPasswordPolicy()
+
+// SetPasswordPolicy replaces the configured password policy. Passing nil
+// restores the default. It is intended for startup configuration, not
+// concurrent use.
+func SetPasswordPolicy(policy *PasswordPolicy) {
+	if policy == nil {
+		policy = DefaultPasswordPolicy()
+	}
+	passwordPolicy = policy
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Position is a catalog entry for a job title. Level orders titles within a
+// career ladder and DepartmentID optionally scopes the title to one
+// department. Validation of employee positions against the catalog sits
+// behind a feature flag so existing free-text data keeps working.
+type Position struct {
+	ID           uuid.UUID  `json:"id"`
+	Title        string     `json:"title"`
+	Level        int        `json:"level"`
+	DepartmentID *uuid.UUID `json:"departmentId,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// NewPosition constructs a validated Position with generated ID and
+// timestamps.
+func NewPosition(title string, level int, departmentID *uuid.UUID) (*Position, error) {
+	now := time.Now().UTC()
+	p := &Position{
+		ID:           uuid.New(),
+		Title:        strings.TrimSpace(title),
+		Level:        level,
+		DepartmentID: departmentID,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks all invariants on the position record.
+func (p *Position) Validate() error {
+	if p.Title == "" {
+		return fmt.Errorf("position title is required")
+	}
+	if len(p.Title) > 100 {
+		return fmt.Errorf("position title must be at most 100 characters")
+	}
+	if p.Level < 1 {
+		return fmt.Errorf("position level must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SortDirection orders list results ascending or descending.
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "ASC"
+	SortDesc SortDirection = "DESC"
+)
+
+// Pagination carries cursor- or offset-based paging parameters. When After
+// is set the repository uses keyset pagination; otherwise Offset applies.
+type Pagination struct {
+	Limit  int
+	Offset int
+	After  string
+}
+
+// PageNumbers computes 1-based page metadata for an offset-mode request.
+// It returns (0, 0) in cursor mode or when Limit is unset, so callers can
+// leave the page fields zero and let cursor pagination rely on PageInfo.
+func (p Pagination) PageNumbers(total int) (totalPages, currentPage int) {
+	if p.After != "" || p.Limit <= 0 {
+		return 0, 0
+	}
+	totalPages = (total + p.Limit - 1) / p.Limit
+	currentPage = p.Offset/p.Limit + 1
+	return totalPages, currentPage
+}
+
+// PageSizeLimits bounds listing page sizes for one role: Default applies
+// when the caller passes no limit and Max is the largest page a request
+// may ask for before it is rejected with ErrPageSizeTooLarge.
+type PageSizeLimits struct {
+	Default int
+	Max     int
+}
+
+// DefaultPageSizeLimits returns the per-role page-size limits applied to
+// employee listings. Privileged roles may fetch larger pages; viewers are
+// held to small ones so they cannot sweep the directory in one request.
+func DefaultPageSizeLimits() map[UserRole]PageSizeLimits {
+	return map[UserRole]PageSizeLimits{
+		RoleAdmin:   {Default: 20, Max: 200},
+		RoleHR:      {Default: 20, Max: 100},
+		RoleManager: {Default: 20, Max: 50},
+		RoleViewer:  {Default: 20, Max: 25},
+	}
+}
+
+// fallbackPageSizeLimits applies when the requester is unknown or their
+// role has no configured entry, preserving the historical 20-default,
+// 100-max clamp for internal callers.
+var fallbackPageSizeLimits = PageSizeLimits{Default: 20, Max: 100}
+
+// EmployeeSort names the column and direction for employee listings.
+type EmployeeSort struct {
+	Field     string
+	Direction SortDirection
+}
+
+// DefaultEmployeeSort is applied when a caller passes an empty
+// EmployeeSort: newest records first, with ID as a tiebreaker, so
+// unsorted listings paginate in a stable order.
+var DefaultEmployeeSort = EmployeeSort{Field: "createdAt", Direction: SortDesc}
+
+// EmployeeFilter narrows employee queries. Zero-value fields are ignored.
+type EmployeeFilter struct {
+	Department string
+	Position   string
+	Status     EmployeeStatus
+	ManagerID  *uuid.UUID
+	Search     string
+	HiredAfter *time.Time
+}
+
+// PageInfo is the cursor-pagination metadata returned with list results.
+type PageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     string
+	EndCursor       string
+}
+
+// EmployeeResult is a page of employees plus paging metadata. TotalPages
+// and CurrentPage are 1-based and populated only in offset mode; cursor
+// requests leave them zero and navigate through PageInfo instead.
+type EmployeeResult struct {
+	Employees   []*Employee
+	TotalCount  int
+	TotalPages  int
+	CurrentPage int
+	PageInfo    PageInfo
+}
+
+// UserFilter narrows user queries. Zero-value fields are ignored. Every
+// field is translated into a parameterized WHERE clause by the repository;
+// nothing is filtered in memory.
+type UserFilter struct {
+	Search         string
+	Role           UserRole
+	IsActive       *bool
+	LastLoginSince *time.Time
+	NeverLoggedIn  bool
+}
+
+// Validate rejects filters that cannot be translated into a sensible
+// query: unknown roles and whitespace-only search terms.
+func (f UserFilter) Validate() error {
+	if f.Role != "" && !f.Role.IsValid() {
+		return fmt.Errorf("invalid user role: %s", f.Role)
+	}
+	if f.Search != "" && strings.TrimSpace(f.Search) == "" {
+		return fmt.Errorf("search term cannot be blank")
+	}
+	return nil
+}
+
+// UserResult is a page of users plus paging metadata. TotalPages and
+// CurrentPage follow the same offset-only convention as EmployeeResult.
+type UserResult struct {
+	Users       []*User
+	TotalCount  int
+	TotalPages  int
+	CurrentPage int
+	PageInfo    PageInfo
+}
+
+// EmployeeRepository is the persistence port for employees. GetByID
+// returns ErrEmployeeNotFound when no row matches; the Find methods are
+// optional lookups and return (nil, nil) so callers can probe for a
+// record without treating absence as an error.
+type EmployeeRepository interface {
+	Create(ctx context.Context, employee *Employee) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Employee, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Employee, error)
+	Update(ctx context.Context, employee *Employee) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filter EmployeeFilter, sort EmployeeSort, pagination Pagination) (*EmployeeResult, error)
+	// ListFields is List fetching only the named employee fields (API
+	// names such as "firstName"), so large listings do not pay for columns
+	// the caller will not read. ID, tenant, and version are always
+	// fetched, unknown names are ignored, and unselected fields are left
+	// at their zero values. A nil fields slice fetches everything.
+	ListFields(ctx context.Context, filter EmployeeFilter, sort EmployeeSort, pagination Pagination, fields []string) (*EmployeeResult, error)
+	// StreamEmployees feeds every employee matching the filter to fn in
+	// chronological order, iterating a single query so exports of any size
+	// run in bounded memory. Iteration stops at the first callback error,
+	// which is returned unchanged.
+	StreamEmployees(ctx context.Context, filter EmployeeFilter, fn func(*Employee) error) error
+	Count(ctx context.Context, filter EmployeeFilter) (int, error)
+	Suggest(ctx context.Context, prefix string, limit int) ([]*Employee, error)
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	ExistsInDepartment(ctx context.Context, department string) (bool, error)
+	FindByEmail(ctx context.Context, email string) (*Employee, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*Employee, error)
+	ListByManager(ctx context.Context, managerID uuid.UUID) ([]*Employee, error)
+	ReassignManager(ctx context.Context, fromManagerID, toManagerID uuid.UUID) error
+}
+
+// UserRepository is the persistence port for user accounts. GetByID
+// returns ErrUserNotFound when no row matches; the Find methods are
+// optional lookups and return (nil, nil).
+type UserRepository interface {
+	Create(ctx context.Context, user *User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*User, error)
+	FindByUsername(ctx context.Context, username string) (*User, error)
+	FindByEmail(ctx context.Context, email string) (*User, error)
+	Update(ctx context.Context, user *User) error
+	List(ctx context.Context, filter UserFilter, pagination Pagination) (*UserResult, error)
+	ExistsByUsername(ctx context.Context, username string) (bool, error)
+}
+
+// DepartmentRepository is the persistence port for departments. GetByID
+// returns ErrDepartmentNotFound when no row matches; FindByName is an
+// optional lookup and returns (nil, nil).
+type DepartmentRepository interface {
+	Create(ctx context.Context, department *Department) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Department, error)
+	FindByName(ctx context.Context, name string) (*Department, error)
+	List(ctx context.Context) ([]*Department, error)
+}
+
+// PositionRepository is the persistence port for the job-title catalog.
+// GetByID returns ErrPositionNotFound when no row matches; FindByTitle is
+// an optional lookup and returns (nil, nil).
+type PositionRepository interface {
+	Create(ctx context.Context, position *Position) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Position, error)
+	FindByTitle(ctx context.Context, title string) (*Position, error)
+	List(ctx context.Context) ([]*Position, error)
+}
+
+// AuditLogRepository is the persistence port for audit records.
+// ScrubEmployeeSnapshots supports erasure requests: it overwrites the
+// given snapshot fields with the anonymization tombstone in every log
+// referencing the employee while keeping the action records themselves.
+type AuditLogRepository interface {
+	Create(ctx context.Context, log *AuditLog) error
+	List(ctx context.Context, filter AuditLogFilter, pagination Pagination) ([]*AuditLog, int, error)
+	CountByOperation(ctx context.Context, filter AuditLogFilter) (map[string]int, error)
+	ScrubEmployeeSnapshots(ctx context.Context, employeeID uuid.UUID, fields []string) error
+}
+
+// Transactor runs a function inside a single storage transaction: writes
+// made through the repositories with the function's context commit
+// together or roll back on error. Stores without transactions (the
+// in-memory repositories) simply omit one and run writes directly.
+type Transactor interface {
+	Transact(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventStoreRepository is the persistence port for domain events. Stores
+// are append-only; every saved event receives a monotonically increasing
+// sequence number so replays observe a stable order.
+type EventStoreRepository interface {
+	SaveEvent(ctx context.Context, event DomainEvent) error
+	LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]*StoredEvent, error)
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RequestContext carries the acting user and request metadata through a
+// context.Context. The HTTP middleware sets it once per request so service
+// calls do not need to thread userID, ipAddress, and userAgent as three
+// separate parameters; the FromContext method variants below read it.
+type RequestContext struct {
+	UserID    uuid.UUID
+	IPAddress string
+	UserAgent string
+	// RequestID is the correlation ID for the request, taken from the
+	// X-Request-ID header or generated by the middleware. It is stamped
+	// onto audit records and error responses so client reports can be
+	// matched to server logs.
+	RequestID string
+}
+
+// requestContextKey is the private context key for RequestContext values.
+type requestContextKey struct{}
+
+// WithRequestContext returns a context carrying rc.
+func WithRequestContext(ctx context.Context, rc RequestContext) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, rc)
+}
+
+// RequestContextFrom returns the RequestContext stored in ctx, or the zero
+// value when none was set.
+func RequestContextFrom(ctx context.Context) RequestContext {
+	rc, _ := ctx.Value(requestContextKey{}).(RequestContext)
+	return rc
+}
+
+// CreateEmployeeFromContext is CreateEmployee reading the acting user and
+// request metadata from the RequestContext.
+func (s *EmployeeService) CreateEmployeeFromContext(ctx context.Context, employee *Employee, idempotencyKey string) (*Employee, error) {
+	rc := RequestContextFrom(ctx)
+	return s.CreateEmployee(ctx, employee, idempotencyKey, rc.UserID, rc.IPAddress, rc.UserAgent)
+}
+
+// UpdateEmployeeFromContext is UpdateEmployee reading the acting user and
+// request metadata from the RequestContext.
+func (s *EmployeeService) UpdateEmployeeFromContext(ctx context.Context, id uuid.UUID, updates map[string]any) (*Employee, error) {
+	rc := RequestContextFrom(ctx)
+	return s.UpdateEmployee(ctx, id, updates, rc.UserID, rc.IPAddress, rc.UserAgent)
+}
+
+// DeleteEmployeeFromContext is DeleteEmployee reading the acting user and
+// request metadata from the RequestContext.
+func (s *EmployeeService) DeleteEmployeeFromContext(ctx context.Context, id uuid.UUID) error {
+	rc := RequestContextFrom(ctx)
+	return s.DeleteEmployee(ctx, id, rc.UserID, rc.IPAddress, rc.UserAgent)
+}
+
+// UpdateSalaryFromContext is UpdateSalary reading the acting user and
+// request metadata from the RequestContext.
+func (s *EmployeeService) UpdateSalaryFromContext(ctx context.Context, id uuid.UUID, salary float64) (*Employee, error) {
+	rc := RequestContextFrom(ctx)
+	return s.UpdateSalary(ctx, id, salary, rc.UserID, rc.IPAddress, rc.UserAgent)
+}
+
+// UpdateMyProfileFromContext is UpdateMyProfile acting as the user in the
+// RequestContext.
+func (s *EmployeeService) UpdateMyProfileFromContext(ctx context.Context, updates map[string]any) (*Employee, error) {
+	rc := RequestContextFrom(ctx)
+	return s.UpdateMyProfile(ctx, rc.UserID, updates, rc.IPAddress, rc.UserAgent)
+}
+
+// AuthenticateFromContext is Authenticate reading request metadata from the
+// RequestContext.
+func (s *UserService) AuthenticateFromContext(ctx context.Context, method string, credentials map[string]any) (*User, error) {
+	rc := RequestContextFrom(ctx)
+	return s.Authenticate(ctx, method, credentials, rc.IPAddress, rc.UserAgent)
+}
+
+// ChangePasswordFromContext is ChangePassword acting as the user in the
+// RequestContext.
+func (s *UserService) ChangePasswordFromContext(ctx context.Context, current, newPassword string) error {
+	rc := RequestContextFrom(ctx)
+	return s.ChangePassword(ctx, rc.UserID, current, newPassword, rc.IPAddress, rc.UserAgent)
+}
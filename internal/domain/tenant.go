@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTenantID is the tenant used when none is set, so single-tenant
+// deployments keep working without any configuration. It is the zero UUID,
+// matching the column default in the database.
+var DefaultTenantID = uuid.Nil
+
+// tenantContextKey is the private context key for the current tenant.
+type tenantContextKey struct{}
+
+// crossTenantContextKey is the private context key for the cross-tenant
+// access flag.
+type crossTenantContextKey struct{}
+
+// WithTenant returns a context scoped to the given tenant. Repositories
+// restrict every query to this tenant, so one company can never read
+// another's data.
+func WithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenantID)
+}
+
+// TenantFrom returns the tenant stored in ctx, or DefaultTenantID when
+// none was set.
+func TenantFrom(ctx context.Context) uuid.UUID {
+	if tenantID, ok := ctx.Value(tenantContextKey{}).(uuid.UUID); ok {
+		return tenantID
+	}
+	return DefaultTenantID
+}
+
+// WithCrossTenantAccess marks the context as allowed to read across all
+// tenants. This is a system-level escape hatch for maintenance jobs and
+// must never be set from request input.
+func WithCrossTenantAccess(ctx context.Context) context.Context {
+	return context.WithValue(ctx, crossTenantContextKey{}, true)
+}
+
+// CrossTenantAccessAllowed reports whether ctx may bypass tenant scoping.
+func CrossTenantAccessAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(crossTenantContextKey{}).(bool)
+	return allowed
+}
+
+// TenantVisible reports whether an entity belonging to tenantID may be
+// seen by ctx. The in-memory repositories use it to mirror the SQL
+// scoping.
+func TenantVisible(ctx context.Context, tenantID uuid.UUID) bool {
+	return CrossTenantAccessAllowed(ctx) || tenantID == TenantFrom(ctx)
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserRole controls what a user account is allowed to do.
+type UserRole string
+
+const (
+	RoleAdmin   UserRole = "ADMIN"
+	RoleHR      UserRole = "HR"
+	RoleManager UserRole = "MANAGER"
+	RoleViewer  UserRole = "VIEWER"
+)
+
+// IsValid reports whether r is one of the known roles.
+func (r UserRole) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleHR, RoleManager, RoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanViewSalary reports whether the role may read salary data, matching
+// the roles allowed to change it.
+func (r UserRole) CanViewSalary() bool {
+	return r == RoleAdmin || r == RoleHR
+}
+
+// User is an authentication account. It is distinct from Employee: not
+// every employee has a login and not every login belongs to an employee.
+// Version implements optimistic locking, mirroring Employee: the repository
+// rejects writes whose expected version is stale.
+type User struct {
+	ID           uuid.UUID  `json:"id"`
+	Username     string     `json:"username"`
+	Email        string     `json:"email"`
+	PasswordHash string     `json:"-"`
+	Role         UserRole   `json:"role"`
+	IsActive     bool       `json:"isActive"`
+	LastLogin    *time.Time `json:"lastLogin,omitempty"`
+	// TenantID scopes the account to a hosted company; the zero UUID is
+	// the default tenant for single-tenant deployments.
+	TenantID uuid.UUID `json:"tenantId,omitempty"`
+	Version  int       `json:"version"`
+	// TokenVersion is embedded in issued JWTs and bumped on password
+	// change, so tokens issued before the change stop authenticating.
+	TokenVersion int       `json:"-"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// NewUser constructs a validated User with a bcrypt-hashed password.
+func NewUser(username, email, password string, role UserRole) (*User, error) {
+	if err := validateUsername(username); err != nil {
+		return nil, err
+	}
+	email = NormalizeEmail(email)
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid user role: %s", role)
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return &User{
+		ID:           uuid.New(),
+		Username:     username,
+		Email:        email,
+		PasswordHash: hash,
+		Role:         role,
+		IsActive:     true,
+		Version:      1,
+		TokenVersion: 1,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// HashPassword validates password strength and returns a bcrypt hash.
+func HashPassword(password string) (string, error) {
+	if err := validatePasswordStrength(password); err != nil {
+		return "", err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether the plaintext password matches the stored hash.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// ChangePassword verifies the current password and replaces it with a new
+// validated and hashed one. Bumping TokenVersion invalidates every token
+// issued before the change, forcing other sessions to log in again.
+func (u *User) ChangePassword(current, newPassword string) error {
+	if !u.CheckPassword(current) {
+		return ErrInvalidCredentials
+	}
+	hash, err := HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hash
+	u.TokenVersion++
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Clone returns a copy of the user with the password hash redacted, safe
+// for handing to presentation layers.
+func (u *User) Clone() *User {
+	clone := *u
+	clone.PasswordHash = ""
+	if u.LastLogin != nil {
+		t := *u.LastLogin
+		clone.LastLogin = &t
+	}
+	return &clone
+}
+
+// CloneWithPassword returns a full copy including the password hash, for
+// internal use such as snapshotting before an update.
+func (u *User) CloneWithPassword() *User {
+	clone := *u
+	if u.LastLogin != nil {
+		t := *u.LastLogin
+		clone.LastLogin = &t
+	}
+	return &clone
+}
+
+// NormalizeUsername returns the canonical lowercase form of a username,
+// used for uniqueness checks and lookups so that "Admin" and "admin"
+// name the same account. The stored record keeps the display case the
+// user registered with.
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// validateUsername enforces the configured account naming policy; the
+// default is 3-30 characters, alphanumeric plus underscore, not starting
+// or ending with underscore. See UsernamePolicy.
+func validateUsername(username string) error {
+	return usernamePolicy.Validate(username)
+}
+
+// validatePasswordStrength enforces the configured password policy.
+func validatePasswordStrength(password string) error {
+	return passwordPolicy.Validate(password)
+}
@@ -0,0 +1,481 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// loginRateLimit is the number of failed attempts per username allowed
+// within the rate-limit window before authentication is refused.
+const (
+	loginRateLimit  = 5
+	rateLimitWindow = 15 * time.Minute
+)
+
+// passwordResetLimit is the number of reset requests allowed per email or
+// IP within the reset window before further requests are silently dropped.
+const (
+	passwordResetLimit  = 3
+	passwordResetWindow = time.Hour
+)
+
+// UserService implements account management and authentication use cases.
+type UserService struct {
+	userRepo      UserRepository
+	employeeRepo  EmployeeRepository
+	auditRepo     AuditLogRepository
+	dispatcher    *EventDispatcher
+	geoResolver   GeoIPResolver
+	loginDetector *LoginDetector
+	strictAudit   bool
+	auditAlerter  AuditAlerter
+	alertSeverity AuditSeverity
+
+	mu            sync.Mutex
+	attempts      map[string][]time.Time
+	resetAttempts map[string][]time.Time
+}
+
+// SetStrictAudit controls whether audit-log write failures fail the
+// business operation. Regulated environments enable this so no action can
+// complete unlogged; by default failures are only printed.
+func (s *UserService) SetStrictAudit(strict bool) {
+	s.strictAudit = strict
+}
+
+// SetLoginDetector installs an optional detector that flags anomalous
+// logins with a SuspiciousLoginDetectedEvent.
+func (s *UserService) SetLoginDetector(detector *LoginDetector) {
+	s.loginDetector = detector
+}
+
+// SetGeoIPResolver installs an optional resolver used to stamp audit logs
+// with the geographic origin of the acting IP address.
+func (s *UserService) SetGeoIPResolver(resolver GeoIPResolver) {
+	s.geoResolver = resolver
+}
+
+// SetAuditAlerter installs an alerter notified whenever an audit log at or
+// above minSeverity is recorded.
+func (s *UserService) SetAuditAlerter(alerter AuditAlerter, minSeverity AuditSeverity) {
+	s.auditAlerter = alerter
+	s.alertSeverity = minSeverity
+}
+
+// NewUserService wires a UserService from its dependencies.
+func NewUserService(userRepo UserRepository, auditRepo AuditLogRepository, dispatcher *EventDispatcher) *UserService {
+	return &UserService{
+		userRepo:      userRepo,
+		auditRepo:     auditRepo,
+		dispatcher:    dispatcher,
+		attempts:      make(map[string][]time.Time),
+		resetAttempts: make(map[string][]time.Time),
+	}
+}
+
+// CreateUser validates and persists a new user account.
+func (s *UserService) CreateUser(ctx context.Context, user *User, userID uuid.UUID, ipAddress, userAgent string) (*User, error) {
+	exists, err := s.userRepo.ExistsByUsername(ctx, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check username uniqueness: %w", err)
+	}
+	if exists {
+		return nil, ErrUsernameAlreadyExists
+	}
+	if user.TenantID == uuid.Nil {
+		user.TenantID = TenantFrom(ctx)
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &userID, nil, OperationCreateUser, nil,
+		map[string]any{"username": user.Username, "role": string(user.Role)}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUser fetches a single user by ID.
+func (s *UserService) GetUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// AuthenticateUser verifies credentials and returns the user. Failed
+// attempts count toward a per-username rate limit keyed on the canonical
+// lowercase username, so case variants share one budget.
+func (s *UserService) AuthenticateUser(ctx context.Context, username, password, ipAddress, userAgent string) (*User, error) {
+	attemptKey := NormalizeUsername(username)
+	if s.isRateLimited(attemptKey) {
+		s.recordLoginFailure(ctx, nil, username, LoginFailureRateLimited, ipAddress, userAgent)
+		return nil, s.rateLimitError(attemptKey)
+	}
+	// Login runs before the caller's tenant is known, so the identity
+	// lookup crosses tenants; everything after it is scoped to the
+	// authenticated user's tenant.
+	user, err := s.userRepo.FindByUsername(WithCrossTenantAccess(ctx), username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil || !user.CheckPassword(password) {
+		s.recordFailedAttempt(attemptKey)
+		s.recordLoginFailure(ctx, user, username, LoginFailureInvalidCredentials, ipAddress, userAgent)
+		return nil, ErrInvalidCredentials
+	}
+	if !user.IsActive {
+		s.recordLoginFailure(ctx, user, username, LoginFailureInactive, ipAddress, userAgent)
+		return nil, ErrUserInactive
+	}
+	s.clearAttempts(attemptKey)
+	ctx = WithTenant(ctx, user.TenantID)
+
+	if err := s.UpdateLastLogin(ctx, user.ID); err != nil {
+		fmt.Printf("failed to update last login: %v\n", err)
+	}
+	if err := s.writeAuditLog(ctx, &user.ID, nil, OperationLogin, nil,
+		map[string]any{"username": user.Username}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.Dispatch(ctx, NewUserLoggedInEvent(user, ipAddress))
+	}
+	if s.loginDetector != nil {
+		location := resolveLocation(s.geoResolver, ipAddress)
+		if reason, suspicious := s.loginDetector.Check(user.ID, location, time.Now()); suspicious && s.dispatcher != nil {
+			s.dispatcher.Dispatch(ctx, NewSuspiciousLoginDetectedEvent(user, ipAddress, location, reason))
+		}
+	}
+	return user, nil
+}
+
+// recordLoginFailure writes the LOGIN_FAILED audit log and dispatches a
+// LoginFailedEvent. user is nil when the identifier did not resolve to an
+// account; for known accounts the log is scoped to their tenant. The
+// failure record is best-effort: the caller's authentication error must
+// be returned regardless, so audit write failures are only printed.
+func (s *UserService) recordLoginFailure(ctx context.Context, user *User, username, reason, ipAddress, userAgent string) {
+	var userID *uuid.UUID
+	aggregateID := uuid.Nil
+	if user != nil {
+		userID = &user.ID
+		aggregateID = user.ID
+		ctx = WithTenant(ctx, user.TenantID)
+	}
+	if err := s.writeAuditLog(ctx, userID, nil, OperationLoginFailed, nil,
+		map[string]any{"username": username, "reason": reason}, ipAddress, userAgent); err != nil {
+		fmt.Printf("failed to record login failure: %v\n", err)
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.Dispatch(ctx, NewLoginFailedEvent(aggregateID, username, ipAddress, reason))
+	}
+}
+
+// UpdateLastLogin stamps the user's last-login time to now.
+func (s *UserService) UpdateLastLogin(ctx context.Context, id uuid.UUID) error {
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	user.LastLogin = &now
+	user.UpdatedAt = now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+	return nil
+}
+
+// InitiatePasswordReset starts a password reset for the account holding the
+// given email. It always reports success so callers cannot probe which
+// emails exist, and requests beyond the per-email and per-IP rate limits
+// are silently dropped. The reset event only fires for existing accounts.
+func (s *UserService) InitiatePasswordReset(ctx context.Context, email, ipAddress, userAgent string) error {
+	if s.isResetRateLimited(NormalizeEmail(email)) || (ipAddress != "" && s.isResetRateLimited(ipAddress)) {
+		return nil
+	}
+	s.recordResetAttempt(NormalizeEmail(email))
+	if ipAddress != "" {
+		s.recordResetAttempt(ipAddress)
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to find user by email: %w", err)
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+	if s.dispatcher != nil {
+		s.dispatcher.Dispatch(ctx, NewPasswordResetRequestedEvent(user, uuid.NewString()))
+	}
+	return nil
+}
+
+// ChangePassword verifies the current password and stores a new one.
+func (s *UserService) ChangePassword(ctx context.Context, id uuid.UUID, current, newPassword, ipAddress, userAgent string) error {
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := user.ChangePassword(current, newPassword); err != nil {
+		return err
+	}
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &id, nil, OperationChangePassword, nil, nil, ipAddress, userAgent); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateUserProfile changes the profile fields of a user account. The
+// caller supplies the version it last read; a stale version returns
+// ErrConcurrentModification instead of silently overwriting a concurrent
+// edit.
+func (s *UserService) UpdateUserProfile(ctx context.Context, id uuid.UUID, email string, expectedVersion int, userID uuid.UUID, ipAddress, userAgent string) (*User, error) {
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.Version != expectedVersion {
+		return nil, ErrConcurrentModification
+	}
+	email = NormalizeEmail(email)
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	oldEmail := user.Email
+	user.Email = email
+	user.UpdatedAt = time.Now().UTC()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &userID, nil, OperationUpdateUser,
+		map[string]any{"email": oldEmail}, map[string]any{"email": email}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ChangeUserRole assigns a new role to the target user. Like
+// UpdateUserProfile it checks the caller's expected version so two admins
+// editing the same account cannot lose each other's changes.
+func (s *UserService) ChangeUserRole(ctx context.Context, id uuid.UUID, role UserRole, expectedVersion int, userID uuid.UUID, ipAddress, userAgent string) (*User, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid user role: %s", role)
+	}
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.Version != expectedVersion {
+		return nil, ErrConcurrentModification
+	}
+	oldRole := user.Role
+	user.Role = role
+	user.UpdatedAt = time.Now().UTC()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to save user: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &userID, nil, OperationChangeRole,
+		map[string]any{"role": string(oldRole)}, map[string]any{"role": string(role)}, ipAddress, userAgent); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeactivateUser disables a user account so it can no longer log in. It
+// refuses to deactivate the calling admin's own account or the last
+// remaining active admin, so the system cannot be locked out.
+func (s *UserService) DeactivateUser(ctx context.Context, id uuid.UUID, userID uuid.UUID, ipAddress, userAgent string) error {
+	if id == userID {
+		return ErrCannotDeactivateSelf
+	}
+	user, err := s.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.Role == RoleAdmin && user.IsActive {
+		last, err := s.isLastActiveAdmin(ctx)
+		if err != nil {
+			return err
+		}
+		if last {
+			return ErrLastAdmin
+		}
+	}
+	user.IsActive = false
+	user.UpdatedAt = time.Now().UTC()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+	if err := s.writeAuditLog(ctx, &userID, nil, OperationDeactivateUser,
+		map[string]any{"isActive": true}, map[string]any{"isActive": false}, ipAddress, userAgent); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isLastActiveAdmin reports whether exactly one active ADMIN account
+// remains.
+func (s *UserService) isLastActiveAdmin(ctx context.Context) (bool, error) {
+	active := true
+	result, err := s.userRepo.List(ctx, UserFilter{Role: RoleAdmin, IsActive: &active}, Pagination{Limit: 1})
+	if err != nil {
+		return false, fmt.Errorf("failed to count active admins: %w", err)
+	}
+	return result.TotalCount <= 1, nil
+}
+
+// SearchUsers lists users matching the given filter.
+func (s *UserService) SearchUsers(ctx context.Context, filter UserFilter, pagination Pagination) (*UserResult, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if pagination.Limit <= 0 {
+		pagination.Limit = 20
+	}
+	if pagination.Limit > 100 {
+		pagination.Limit = 100
+	}
+	result, err := s.userRepo.List(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return result, nil
+}
+
+// ListUsersByRole lists all users holding the given role, paginated with a
+// total count. The role filter is pushed into the repository query.
+func (s *UserService) ListUsersByRole(ctx context.Context, role UserRole, pagination Pagination) (*UserResult, error) {
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid user role: %s", role)
+	}
+	return s.SearchUsers(ctx, UserFilter{Role: role}, pagination)
+}
+
+// GetUserActivitySummary aggregates the user's audit trail over a period
+// into counts per operation type, e.g. {"UPDATE_EMPLOYEE": 12, "LOGIN": 3}.
+func (s *UserService) GetUserActivitySummary(ctx context.Context, userID uuid.UUID, start, end time.Time) (map[string]int, error) {
+	counts, err := s.auditRepo.CountByOperation(ctx, AuditLogFilter{UserID: &userID, From: &start, To: &end})
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize user activity: %w", err)
+	}
+	return counts, nil
+}
+
+// GetRecentLogins lists users whose last login is at or after the given
+// time, for security reviews of recent account activity.
+func (s *UserService) GetRecentLogins(ctx context.Context, since time.Time, pagination Pagination) (*UserResult, error) {
+	return s.SearchUsers(ctx, UserFilter{LastLoginSince: &since}, pagination)
+}
+
+// GetNeverLoggedIn lists users that have never logged in, typically stale
+// accounts flagged during offboarding reviews.
+func (s *UserService) GetNeverLoggedIn(ctx context.Context, pagination Pagination) (*UserResult, error) {
+	return s.SearchUsers(ctx, UserFilter{NeverLoggedIn: true}, pagination)
+}
+
+// isRateLimited reports whether the username has exceeded the failed-login
+// budget inside the current window.
+func (s *UserService) isRateLimited(username string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := time.Now().Add(-rateLimitWindow)
+	recent := s.attempts[username][:0]
+	for _, t := range s.attempts[username] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	s.attempts[username] = recent
+	return len(recent) >= loginRateLimit
+}
+
+// rateLimitError builds the RateLimitedError for a limited key, waiting
+// until the oldest counted attempt ages out of the window.
+func (s *UserService) rateLimitError(username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	retryAfter := rateLimitWindow
+	if attempts := s.attempts[username]; len(attempts) > 0 {
+		retryAfter = time.Until(attempts[0].Add(rateLimitWindow))
+	}
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	return &RateLimitedError{RetryAfter: retryAfter}
+}
+
+func (s *UserService) recordFailedAttempt(username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts[username] = append(s.attempts[username], time.Now())
+}
+
+// isResetRateLimited reports whether the email or IP has exhausted its
+// password-reset budget inside the current window.
+func (s *UserService) isResetRateLimited(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := time.Now().Add(-passwordResetWindow)
+	recent := s.resetAttempts[key][:0]
+	for _, t := range s.resetAttempts[key] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	s.resetAttempts[key] = recent
+	return len(recent) >= passwordResetLimit
+}
+
+func (s *UserService) recordResetAttempt(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resetAttempts[key] = append(s.resetAttempts[key], time.Now())
+}
+
+func (s *UserService) clearAttempts(username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.attempts, username)
+}
+
+// writeAuditLog records an audit entry. In strict mode failures are
+// returned so the business operation fails; otherwise they are printed and
+// the operation continues.
+func (s *UserService) writeAuditLog(ctx context.Context, userID, employeeID *uuid.UUID, operation string, oldValues, newValues map[string]any, ipAddress, userAgent string) error {
+	log, err := NewAuditLog(userID, employeeID, operation, oldValues, newValues, ipAddress, userAgent)
+	if err != nil {
+		if s.strictAudit {
+			return fmt.Errorf("failed to build audit log: %w", err)
+		}
+		fmt.Printf("failed to build audit log: %v\n", err)
+		return nil
+	}
+	log.Location = resolveLocation(s.geoResolver, ipAddress)
+	log.RequestID = RequestContextFrom(ctx).RequestID
+	log.TenantID = TenantFrom(ctx)
+	if err := s.auditRepo.Create(ctx, log); err != nil {
+		if s.strictAudit {
+			return fmt.Errorf("failed to write audit log: %w", err)
+		}
+		fmt.Printf("failed to write audit log: %v\n", err)
+	}
+	if s.auditAlerter != nil && log.Severity.AtLeast(s.alertSeverity) {
+		s.auditAlerter.Alert(ctx, log)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// UsernamePolicy describes the account naming rules enforced when users
+// are created. Deployments can swap the configured policy via
+// SetUsernamePolicy; the default matches the rules the service has always
+// enforced.
+type UsernamePolicy struct {
+	// MinLength and MaxLength bound the username length.
+	MinLength int
+	MaxLength int
+	// AllowDots and AllowHyphens admit '.' and '-' in addition to
+	// letters, digits, and underscores.
+	AllowDots    bool
+	AllowHyphens bool
+	// AllowEmail accepts a valid email address as the username,
+	// bypassing the length and character rules.
+	AllowEmail bool
+	// reserved holds normalized names registration must reject; see
+	// SetReservedNames.
+	reserved map[string]struct{}
+}
+
+// DefaultUsernamePolicy returns the historical rules: 3-30 characters,
+// alphanumeric plus underscore, not starting or ending with underscore,
+// and no reserved names.
+func DefaultUsernamePolicy() *UsernamePolicy {
+	return &UsernamePolicy{MinLength: 3, MaxLength: 30}
+}
+
+// SetReservedNames installs a denylist of names (such as "admin", "root",
+// or "system") that Validate rejects with ErrReservedUsername. Matching is
+// case-insensitive.
+func (p *UsernamePolicy) SetReservedNames(names []string) {
+	p.reserved = make(map[string]struct{}, len(names))
+	for _, name := range names {
+		p.reserved[NormalizeUsername(name)] = struct{}{}
+	}
+}
+
+// Validate checks the username against the policy, returning
+// ErrReservedUsername for denylisted names and a descriptive error on the
+// first other violated rule.
+func (p *UsernamePolicy) Validate(username string) error {
+	if p.reserved != nil {
+		if _, found := p.reserved[NormalizeUsername(username)]; found {
+			return ErrReservedUsername
+		}
+	}
+	if p.AllowEmail && strings.Contains(username, "@") {
+		if err := validateEmail(username); err != nil {
+			return fmt.Errorf("username is not a valid email: %w", err)
+		}
+		return nil
+	}
+	if username == "" {
+		return fmt.Errorf("username is required")
+	}
+	if len(username) < p.MinLength {
+		return fmt.Errorf("username must be at least %d characters", p.MinLength)
+	}
+	if p.MaxLength > 0 && len(username) > p.MaxLength {
+		return fmt.Errorf("username must be at most %d characters", p.MaxLength)
+	}
+	for _, r := range username {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			continue
+		}
+		if (r == '.' && p.AllowDots) || (r == '-' && p.AllowHyphens) {
+			continue
+		}
+		return fmt.Errorf("username may only contain %s", p.allowedCharacters())
+	}
+	if isSeparator(rune(username[0])) || isSeparator(rune(username[len(username)-1])) {
+		return fmt.Errorf("username cannot start or end with a separator")
+	}
+	return nil
+}
+
+// allowedCharacters describes the permitted character set for error
+// messages, reflecting the policy's optional separators.
+func (p *UsernamePolicy) allowedCharacters() string {
+	allowed := "letters, digits, and underscores"
+	if p.AllowDots && p.AllowHyphens {
+		allowed = "letters, digits, underscores, dots, and hyphens"
+	} else if p.AllowDots {
+		allowed = "letters, digits, underscores, and dots"
+	} else if p.AllowHyphens {
+		allowed = "letters, digits, underscores, and hyphens"
+	}
+	return allowed
+}
+
+// isSeparator reports whether the character is one of the separator
+// characters a username may contain but not start or end with.
+func isSeparator(r rune) bool {
+	return r == '_' || r == '.' || r == '-'
+}
+
+// usernamePolicy is the policy consulted by validateUsername.
+var usernamePolicy = DefaultUsernamePolicy()
+
+// SetUsernamePolicy replaces the configured username policy. Passing nil
+// restores the default. It is intended for startup configuration, not
+// concurrent use.
+func SetUsernamePolicy(policy *UsernamePolicy) {
+	if policy == nil {
+		policy = DefaultUsernamePolicy()
+	}
+	usernamePolicy = policy
+}
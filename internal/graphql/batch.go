@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+)
+
+// DefaultMaxBatchSize is how many operations a single batched request may
+// carry. Batching trades round-trips for server concurrency; the cap keeps
+// one request from fanning out into arbitrarily many executions.
+const DefaultMaxBatchSize = 10
+
+// isBatchRequest reports whether the POST body is a JSON array of
+// operations rather than a single operation object.
+func isBatchRequest(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// serveBatch executes a JSON array of operations concurrently and writes
+// an array of results in the same order. Each operation runs with the
+// request's authenticated context and is depth-limited like a single
+// request. The response writer is not shared with resolvers here because
+// concurrent operations would race on response headers, so the
+// authenticate mutation should be sent as a single request when cookie
+// auth is enabled.
+func (h *Handler) serveBatch(w http.ResponseWriter, r *http.Request, body []byte) {
+	var reqs []graphqlRequest
+	if err := json.Unmarshal(body, &reqs); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "invalid request body"}},
+		})
+		return
+	}
+	if len(reqs) == 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "batch must contain at least one operation"}},
+		})
+		return
+	}
+	if len(reqs) > h.maxBatchSize {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "batch size exceeds maximum"}},
+		})
+		return
+	}
+
+	ctx := r.Context()
+	responses := make([]any, len(reqs))
+	executed := make([]*graphql.Result, len(reqs))
+	var wg sync.WaitGroup
+	for i := range reqs {
+		if err := validateQueryDepth(reqs[i].Query, h.maxQueryDepth); err != nil {
+			responses[i] = map[string]any{
+				"errors": []map[string]any{{"message": err.Error()}},
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, req graphqlRequest) {
+			defer wg.Done()
+			// Each operation gets its own server-side deadline, so one
+			// slow query cannot stall the rest of the batch's budget.
+			opCtx, cancel := h.withOperationTimeout(ctx, req.Query, req.OperationName)
+			defer cancel()
+			executed[i] = graphql.Do(graphql.Params{
+				Schema:         h.schema,
+				RequestString:  req.Query,
+				OperationName:  req.OperationName,
+				VariableValues: req.Variables,
+				Context:        opCtx,
+			})
+		}(i, reqs[i])
+	}
+	wg.Wait()
+
+	// Error presentation touches response headers (Retry-After), so it
+	// runs serially once every operation has finished.
+	requestID := requestIDFrom(ctx)
+	for i, result := range executed {
+		if result == nil {
+			continue
+		}
+		h.presentErrors(w, result, requestID)
+		responses[i] = result
+	}
+	writeJSON(w, http.StatusOK, responses)
+}
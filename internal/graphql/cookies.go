@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+)
+
+// AuthCookieName is the cookie that carries the access token for browser
+// clients when cookie auth is enabled.
+const AuthCookieName = "auth-token"
+
+const responseWriterContextKey contextKey = "responseWriter"
+
+// withResponseWriter stores the response writer so resolvers can set
+// cookies. Only installed when cookie auth is enabled on the handler.
+func withResponseWriter(ctx context.Context, w http.ResponseWriter) context.Context {
+	return context.WithValue(ctx, responseWriterContextKey, w)
+}
+
+// setAuthCookie issues the access token as an HttpOnly, Secure,
+// SameSite=Strict cookie, keeping it out of JS-accessible storage. It is a
+// no-op unless the handler enabled cookie auth, so API clients that use the
+// Authorization header are unaffected.
+func setAuthCookie(ctx context.Context, token string) {
+	w, ok := ctx.Value(responseWriterContextKey).(http.ResponseWriter)
+	if !ok {
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     AuthCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(auth.AccessTokenTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
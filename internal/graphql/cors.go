@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CORSConfig controls cross-origin access to the GraphQL endpoint.
+type CORSConfig struct {
+	// AllowedOrigins lists origins allowed to call the API. The single
+	// entry "*" allows any origin.
+	AllowedOrigins []string
+	// AllowedMethods and AllowedHeaders are advertised on preflight
+	// responses.
+	AllowedMethods []string
+	AllowedHeaders []string
+}
+
+// DefaultCORSConfig allows any origin with the methods and headers the
+// GraphQL endpoint actually accepts.
+func DefaultCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{http.MethodPost, http.MethodOptions},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+	}
+}
+
+// CORSMiddleware sets CORS response headers and answers preflight OPTIONS
+// requests. Requests from origins not in the allow list pass through
+// without CORS headers, so browsers block them while same-origin and
+// non-browser clients are unaffected.
+func CORSMiddleware(cfg CORSConfig, next http.Handler) http.Handler {
+	allowedMethods := strings.Join(cfg.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(cfg.AllowedHeaders, ", ")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if allowed := cfg.allowedOrigin(origin); allowed != "" {
+				w.Header().Set("Access-Control-Allow-Origin", allowed)
+				w.Header().Add("Vary", "Origin")
+			}
+		}
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedOrigin returns the Access-Control-Allow-Origin value for the
+// request origin, or "" when the origin is not allowed.
+func (cfg CORSConfig) allowedOrigin(origin string) string {
+	for _, allowed := range cfg.AllowedOrigins {
+		if allowed == "*" {
+			return "*"
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// DefaultMaxQueryDepth is how deeply a query may nest selections before it
+// is rejected. Recursive fields such as manager and org-chart children make
+// unbounded queries possible; this bounds them before execution.
+const DefaultMaxQueryDepth = 10
+
+// validateQueryDepth parses the query and rejects it when any operation
+// nests selections beyond maxDepth. Queries that fail to parse are passed
+// through so the executor reports the syntax error.
+func validateQueryDepth(query string, maxDepth int) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		return nil
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		operation, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		depth := selectionDepth(operation.SelectionSet, fragments, make(map[string]bool))
+		if depth > maxDepth {
+			return fmt.Errorf("query depth %d exceeds maximum allowed depth %d", depth, maxDepth)
+		}
+	}
+	return nil
+}
+
+// selectionDepth returns the deepest field nesting in a selection set.
+// Fragment spreads contribute the depth of their definition; the seen set
+// guards against fragment cycles.
+func selectionDepth(set *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition, seen map[string]bool) int {
+	if set == nil {
+		return 0
+	}
+	depth := 0
+	for _, selection := range set.Selections {
+		var d int
+		switch sel := selection.(type) {
+		case *ast.Field:
+			d = 1 + selectionDepth(sel.SelectionSet, fragments, seen)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet, fragments, seen)
+		case *ast.FragmentSpread:
+			name := sel.Name.Value
+			if fragment, ok := fragments[name]; ok && !seen[name] {
+				seen[name] = true
+				d = selectionDepth(fragment.SelectionSet, fragments, seen)
+				delete(seen, name)
+			}
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
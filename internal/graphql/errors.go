@@ -0,0 +1,141 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// errorCodes maps classified domain errors to the stable machine-readable
+// codes surfaced in GraphQL error extensions. Errors not listed here are
+// treated as internal.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrUnauthenticated, "UNAUTHENTICATED"},
+	{domain.ErrInvalidToken, "UNAUTHENTICATED"},
+	{domain.ErrTokenExpired, "UNAUTHENTICATED"},
+	{domain.ErrUnauthorized, "FORBIDDEN"},
+	{domain.ErrInvalidCredentials, "INVALID_CREDENTIALS"},
+	{domain.ErrUserInactive, "INVALID_CREDENTIALS"},
+	{domain.ErrEmployeeNotFound, "NOT_FOUND"},
+	{domain.ErrUserNotFound, "NOT_FOUND"},
+	{domain.ErrManagerNotFound, "NOT_FOUND"},
+	{domain.ErrDepartmentNotFound, "NOT_FOUND"},
+	{domain.ErrPositionNotFound, "NOT_FOUND"},
+	{domain.ErrNoLinkedEmployee, "NOT_FOUND"},
+	{domain.ErrEmailAlreadyExists, "CONFLICT"},
+	{domain.ErrUsernameAlreadyExists, "CONFLICT"},
+	{domain.ErrEmployeeAlreadyLinked, "CONFLICT"},
+	{domain.ErrUserAlreadyLinked, "CONFLICT"},
+	{domain.ErrConcurrentModification, "CONFLICT"},
+	{domain.ErrInvalidSalary, "VALIDATION"},
+	{domain.ErrCircularManagement, "VALIDATION"},
+	{domain.ErrManagerDepartmentMismatch, "VALIDATION"},
+	{domain.ErrHasDirectReports, "VALIDATION"},
+	{domain.ErrFieldNotSelfEditable, "VALIDATION"},
+	{domain.ErrPageSizeTooLarge, "VALIDATION"},
+	{domain.ErrWeakPassword, "VALIDATION"},
+	{domain.ErrCommonPassword, "VALIDATION"},
+	{domain.ErrCannotDeactivateSelf, "VALIDATION"},
+	{domain.ErrLastAdmin, "VALIDATION"},
+	{domain.ErrUnknownAuthMethod, "VALIDATION"},
+	{domain.ErrInvalidOperation, "VALIDATION"},
+}
+
+// errorCode classifies a resolver error, returning its extension code.
+func errorCode(err error) (string, bool) {
+	var fieldErr *domain.FieldError
+	if errors.As(err, &fieldErr) {
+		return "VALIDATION", true
+	}
+	for _, entry := range errorCodes {
+		if errors.Is(err, entry.err) {
+			return entry.code, true
+		}
+	}
+	return "", false
+}
+
+// presentErrors classifies each GraphQL error for clients. Classified
+// domain errors pass through with their code; rate-limit errors also set a
+// Retry-After header, and operations cut off by the server-side deadline
+// are reported as TIMEOUT. Unclassified errors are internal: when masking is
+// enabled their message is replaced with a generic one carrying a
+// correlation ID that is logged server-side, so DB or infrastructure
+// details never reach clients. The request ID is attached to every error
+// extension so client reports can be matched to server logs.
+func (h *Handler) presentErrors(w http.ResponseWriter, result *graphql.Result, requestID string) {
+	extensions := func(code string) map[string]any {
+		ext := map[string]any{"code": code}
+		if requestID != "" {
+			ext["requestId"] = requestID
+		}
+		return ext
+	}
+	for i := range result.Errors {
+		original := resolverError(result.Errors[i])
+		if original == nil {
+			// Parse and validation errors produced by the GraphQL library
+			// itself are already client-safe.
+			continue
+		}
+
+		if errors.Is(original, context.DeadlineExceeded) {
+			result.Errors[i].Message = "operation timed out"
+			result.Errors[i].Extensions = extensions("TIMEOUT")
+			continue
+		}
+
+		var rateLimited *domain.RateLimitedError
+		if errors.As(original, &rateLimited) {
+			seconds := int(math.Ceil(rateLimited.RetryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			ext := extensions("RATE_LIMITED")
+			ext["retryAfter"] = seconds
+			result.Errors[i].Extensions = ext
+			continue
+		}
+
+		if code, ok := errorCode(original); ok {
+			result.Errors[i].Extensions = extensions(code)
+			continue
+		}
+
+		correlationID := uuid.NewString()
+		log.Printf("internal error [%s] request_id=%s: %v", correlationID, requestID, original)
+		if h.maskInternalErrors {
+			result.Errors[i].Message = "internal server error"
+		}
+		ext := extensions("INTERNAL")
+		ext["correlationId"] = correlationID
+		result.Errors[i].Extensions = ext
+	}
+}
+
+// resolverError digs the original resolver error out of a formatted GraphQL
+// error. The library's error wrappers predate errors.Unwrap, so the chain
+// has to be walked by hand.
+func resolverError(formatted gqlerrors.FormattedError) error {
+	err := formatted.OriginalError()
+	for {
+		switch wrapped := err.(type) {
+		case *gqlerrors.Error:
+			err = wrapped.OriginalError
+		case gqlerrors.FormattedError:
+			err = wrapped.OriginalError()
+		default:
+			return err
+		}
+	}
+}
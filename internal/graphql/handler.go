@@ -0,0 +1,152 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+)
+
+// graphqlRequest is the standard GraphQL-over-HTTP POST body.
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handler serves GraphQL requests over HTTP POST.
+type Handler struct {
+	schema             graphql.Schema
+	maxQueryDepth      int
+	maxBatchSize       int
+	queryTimeout       time.Duration
+	mutationTimeout    time.Duration
+	cors               CORSConfig
+	authCookie         bool
+	maskInternalErrors bool
+}
+
+// HandlerOption customizes the GraphQL handler.
+type HandlerOption func(*Handler)
+
+// WithMaxQueryDepth overrides the default query-depth limit.
+func WithMaxQueryDepth(depth int) HandlerOption {
+	return func(h *Handler) { h.maxQueryDepth = depth }
+}
+
+// WithMaxBatchSize overrides the default limit on operations per batched
+// request.
+func WithMaxBatchSize(size int) HandlerOption {
+	return func(h *Handler) { h.maxBatchSize = size }
+}
+
+// WithOperationTimeouts overrides the default per-operation execution
+// deadlines. Non-positive values disable the corresponding bound.
+func WithOperationTimeouts(query, mutation time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.queryTimeout = query
+		h.mutationTimeout = mutation
+	}
+}
+
+// WithCORS overrides the default CORS configuration.
+func WithCORS(cfg CORSConfig) HandlerOption {
+	return func(h *Handler) { h.cors = cfg }
+}
+
+// WithAuthCookie makes the authenticate mutation also issue the token as
+// an HttpOnly cookie, and the auth middleware accept it.
+func WithAuthCookie() HandlerOption {
+	return func(h *Handler) { h.authCookie = true }
+}
+
+// WithErrorMasking replaces the messages of unclassified internal errors
+// with a generic one carrying a correlation ID. Intended for production,
+// where detailed internal errors must not reach clients.
+func WithErrorMasking() HandlerOption {
+	return func(h *Handler) { h.maskInternalErrors = true }
+}
+
+// NewHandler builds the HTTP handler stack for the GraphQL endpoint,
+// including the CORS and auth middlewares.
+func NewHandler(r *Resolver, tokens *auth.TokenService, opts ...HandlerOption) (http.Handler, error) {
+	schema, err := NewSchema(r)
+	if err != nil {
+		return nil, err
+	}
+	h := &Handler{
+		schema:          schema,
+		maxQueryDepth:   DefaultMaxQueryDepth,
+		maxBatchSize:    DefaultMaxBatchSize,
+		queryTimeout:    DefaultQueryTimeout,
+		mutationTimeout: DefaultMutationTimeout,
+		cors:            DefaultCORSConfig(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return CORSMiddleware(h.cors, RequestIDMiddleware(AuthMiddleware(tokens, r.users, h))), nil
+}
+
+// ServeHTTP executes a single GraphQL operation, or a batch of them when
+// the body is a JSON array; see serveBatch.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "invalid request body"}},
+		})
+		return
+	}
+	if isBatchRequest(body) {
+		h.serveBatch(w, r, body)
+		return
+	}
+	var req graphqlRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"errors": []map[string]any{{"message": "invalid request body"}},
+		})
+		return
+	}
+
+	if err := validateQueryDepth(req.Query, h.maxQueryDepth); err != nil {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"errors": []map[string]any{{"message": err.Error()}},
+		})
+		return
+	}
+
+	ctx := r.Context()
+	if h.authCookie {
+		ctx = withResponseWriter(ctx, w)
+	}
+	// The deadline is enforced server-side regardless of what the client's
+	// context carries; a slow operation cannot hold the connection open.
+	ctx, cancel := h.withOperationTimeout(ctx, req.Query, req.OperationName)
+	defer cancel()
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        ctx,
+	})
+	h.presentErrors(w, result, requestIDFrom(ctx))
+	writeJSON(w, http.StatusOK, result)
+}
+
+// writeJSON serializes a response body with the proper content type.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+type contextKey string
+
+const (
+	claimsContextKey      contextKey = "claims"
+	currentUserContextKey contextKey = "currentUser"
+)
+
+// AuthMiddleware validates an optional Bearer token and stores the claims,
+// the resolved current user, and a domain.RequestContext in the context.
+// Requests without a valid token proceed unauthenticated; resolvers enforce
+// their own requirements.
+func AuthMiddleware(tokens *auth.TokenService, users *domain.UserService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		rc := domain.RequestContext{
+			IPAddress: clientIP(r),
+			UserAgent: r.UserAgent(),
+			RequestID: requestIDFrom(ctx),
+		}
+
+		if tokenString := requestToken(r); tokenString != "" {
+			if claims, err := tokens.ValidateToken(tokenString); err == nil {
+				// The user lookup runs before the tenant is known; once
+				// resolved, every downstream query is scoped to the
+				// user's tenant. Tokens minted before the user's last
+				// password change carry a stale TokenVersion and no
+				// longer authenticate.
+				if user, err := users.GetUser(domain.WithCrossTenantAccess(ctx), claims.UserID); err == nil && user.IsActive && claims.TokenVersion == user.TokenVersion {
+					ctx = context.WithValue(ctx, claimsContextKey, claims)
+					ctx = context.WithValue(ctx, currentUserContextKey, user.Clone())
+					ctx = domain.WithTenant(ctx, user.TenantID)
+					rc.UserID = user.ID
+				}
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(domain.WithRequestContext(ctx, rc)))
+	})
+}
+
+// requestToken extracts the access token from the Authorization header,
+// falling back to the auth cookie for browser clients.
+func requestToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	if cookie, err := r.Cookie(AuthCookieName); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// ClaimsFromContext returns the authenticated token claims, if any.
+func ClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*auth.Claims)
+	return claims, ok
+}
+
+// CurrentUser returns the authenticated user stored by the middleware, if
+// any. The password hash is redacted.
+func CurrentUser(ctx context.Context) (*domain.User, bool) {
+	user, ok := ctx.Value(currentUserContextKey).(*domain.User)
+	return user, ok
+}
+
+// clientIP extracts the originating client address, honoring proxies.
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		if i := strings.Index(forwarded, ","); i >= 0 {
+			return strings.TrimSpace(forwarded[:i])
+		}
+		return strings.TrimSpace(forwarded)
+	}
+	host := r.RemoteAddr
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"sort"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// employeeProjectionFields maps Employee selection fields onto the stored
+// fields the repository must fetch. Derived fields list what they are
+// computed from; fields with their own resolvers (manager, directReports)
+// only need the referenced IDs, and id and version are always fetched so
+// they map to nothing extra.
+var employeeProjectionFields = map[string][]string{
+	"id":             nil,
+	"version":        nil,
+	"__typename":     nil,
+	"firstName":      {"firstName"},
+	"lastName":       {"lastName"},
+	"fullName":       {"firstName", "lastName"},
+	"email":          {"email"},
+	"phone":          {"phone"},
+	"department":     {"department"},
+	"position":       {"position"},
+	"salary":         {"salary"},
+	"salaryCurrency": {"salaryCurrency"},
+	"hireDate":       {"hireDate"},
+	"status":         {"status"},
+	"employmentType": {"employmentType"},
+	"address":        {"address"},
+	"manager":        {"managerId"},
+	"directReports":  nil,
+	"createdAt":      {"createdAt"},
+	"updatedAt":      {"updatedAt"},
+}
+
+// requestedEmployeeFields maps the employees connection selection set onto
+// the repository projection: the union of stored fields needed by the node
+// selections under edges. It returns nil — fetch everything — when the
+// selection names a field the mapping does not know, so additions to the
+// schema fail safe rather than returning zero values.
+func requestedEmployeeFields(p graphql.ResolveParams) []string {
+	if len(p.Info.FieldASTs) == 0 {
+		return nil
+	}
+	needed := make(map[string]bool)
+	for _, field := range p.Info.FieldASTs {
+		for _, edges := range namedChildSets(field.GetSelectionSet(), p.Info.Fragments, "edges") {
+			for _, node := range namedChildSets(edges, p.Info.Fragments, "node") {
+				if !collectProjectionFields(node, p.Info.Fragments, needed) {
+					return nil
+				}
+			}
+		}
+	}
+	projection := make([]string, 0, len(needed))
+	for field := range needed {
+		projection = append(projection, field)
+	}
+	sort.Strings(projection)
+	return projection
+}
+
+// namedChildSets returns the selection sets of every field with the given
+// name in set, looking through inline fragments and fragment spreads.
+func namedChildSets(set *ast.SelectionSet, fragments map[string]ast.Definition, name string) []*ast.SelectionSet {
+	if set == nil {
+		return nil
+	}
+	var sets []*ast.SelectionSet
+	for _, selection := range set.Selections {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if sel.Name != nil && sel.Name.Value == name {
+				sets = append(sets, sel.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			sets = append(sets, namedChildSets(sel.SelectionSet, fragments, name)...)
+		case *ast.FragmentSpread:
+			if fragment, ok := fragments[sel.Name.Value].(*ast.FragmentDefinition); ok {
+				sets = append(sets, namedChildSets(fragment.SelectionSet, fragments, name)...)
+			}
+		}
+	}
+	return sets
+}
+
+// collectProjectionFields walks an Employee selection set, accumulating
+// the stored fields it needs into needed. It reports false on the first
+// field the projection mapping does not know.
+func collectProjectionFields(set *ast.SelectionSet, fragments map[string]ast.Definition, needed map[string]bool) bool {
+	if set == nil {
+		return true
+	}
+	for _, selection := range set.Selections {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			stored, known := employeeProjectionFields[sel.Name.Value]
+			if !known {
+				return false
+			}
+			for _, field := range stored {
+				needed[field] = true
+			}
+		case *ast.InlineFragment:
+			if !collectProjectionFields(sel.SelectionSet, fragments, needed) {
+				return false
+			}
+		case *ast.FragmentSpread:
+			if fragment, ok := fragments[sel.Name.Value].(*ast.FragmentDefinition); ok {
+				if !collectProjectionFields(fragment.SelectionSet, fragments, needed) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
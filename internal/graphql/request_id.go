@@ -0,0 +1,35 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the correlation ID between client and server.
+const RequestIDHeader = "X-Request-ID"
+
+const requestIDContextKey contextKey = "requestID"
+
+// RequestIDMiddleware reads the X-Request-ID header, generating one when
+// absent, stores it in the context, and echoes it on the response. The
+// auth middleware copies it into the domain RequestContext so it reaches
+// logs, error extensions, and audit records.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDContextKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requestIDFrom returns the correlation ID stored by RequestIDMiddleware.
+func requestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
@@ -0,0 +1,393 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// Resolver bundles the services the GraphQL fields delegate to.
+type Resolver struct {
+	employees *domain.EmployeeService
+	users     *domain.UserService
+	tokens    *auth.TokenService
+}
+
+// NewResolver wires a Resolver from the domain services.
+func NewResolver(employees *domain.EmployeeService, users *domain.UserService, tokens *auth.TokenService) *Resolver {
+	return &Resolver{employees: employees, users: users, tokens: tokens}
+}
+
+// requireAuth returns the current user or ErrUnauthenticated.
+func requireAuth(ctx context.Context) (*domain.User, error) {
+	user, ok := CurrentUser(ctx)
+	if !ok {
+		return nil, domain.ErrUnauthenticated
+	}
+	return user, nil
+}
+
+// requireRole returns the current user if they hold one of the given
+// roles, ErrUnauthorized otherwise.
+func requireRole(ctx context.Context, roles ...domain.UserRole) (*domain.User, error) {
+	user, err := requireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if user.Role == role {
+			return user, nil
+		}
+	}
+	return nil, domain.ErrUnauthorized
+}
+
+// resolveMe handles the me query, returning the authenticated user.
+func (r *Resolver) resolveMe(p graphql.ResolveParams) (any, error) {
+	return requireAuth(p.Context)
+}
+
+// resolveMyEmployee handles the myEmployee query, returning the employee
+// record linked to the authenticated user's account.
+func (r *Resolver) resolveMyEmployee(p graphql.ResolveParams) (any, error) {
+	user, err := requireAuth(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	return r.employees.GetMyEmployee(p.Context, user.ID)
+}
+
+// resolveEmployee handles the employee(id) query.
+func (r *Resolver) resolveEmployee(p graphql.ResolveParams) (any, error) {
+	if _, err := requireAuth(p.Context); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee id")
+	}
+	return r.employees.GetEmployee(p.Context, id)
+}
+
+// resolveEmployees handles the employees connection query, projecting the
+// repository fetch down to the fields the selection set actually reads.
+func (r *Resolver) resolveEmployees(p graphql.ResolveParams) (any, error) {
+	user, err := requireAuth(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	filter := parseEmployeeFilter(p.Args["filter"])
+	sort := parseEmployeeSort(p.Args["sort"])
+	pagination := domain.Pagination{Limit: 20}
+	if first, ok := p.Args["first"].(int); ok {
+		pagination.Limit = first
+	}
+	if after, ok := p.Args["after"].(string); ok {
+		pagination.After = after
+	}
+	return r.employees.ListEmployeesFields(p.Context, filter, sort, pagination, requestedEmployeeFields(p), user)
+}
+
+// resolvePositions handles the positions query, listing the job-title
+// catalog for UI pickers.
+func (r *Resolver) resolvePositions(p graphql.ResolveParams) (any, error) {
+	if _, err := requireAuth(p.Context); err != nil {
+		return nil, err
+	}
+	positions, err := r.employees.ListPositions(p.Context)
+	if err != nil {
+		return nil, err
+	}
+	if positions == nil {
+		positions = []*domain.Position{}
+	}
+	return positions, nil
+}
+
+// resolveValidateEmployee handles the validateEmployee query, dry-running
+// an update so forms can show validation feedback before submitting.
+func (r *Resolver) resolveValidateEmployee(p graphql.ResolveParams) (any, error) {
+	if _, err := requireRole(p.Context, domain.RoleAdmin, domain.RoleHR); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee id")
+	}
+	updates, err := jsonObject("input", p.Args["input"])
+	if err != nil {
+		return nil, err
+	}
+	if err := r.employees.ValidateEmployeeUpdate(p.Context, id, updates); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// resolveManager handles the Employee.manager field.
+func (r *Resolver) resolveManager(p graphql.ResolveParams) (any, error) {
+	employee, ok := p.Source.(*domain.Employee)
+	if !ok || employee.ManagerID == nil {
+		return nil, nil
+	}
+	return r.employees.GetEmployee(p.Context, *employee.ManagerID)
+}
+
+// resolveDirectReports handles the Employee.directReports field.
+func (r *Resolver) resolveDirectReports(p graphql.ResolveParams) (any, error) {
+	employee, ok := p.Source.(*domain.Employee)
+	if !ok {
+		return nil, nil
+	}
+	return r.employees.GetDirectReports(p.Context, employee.ID)
+}
+
+// credentialKeys lists the keys each authentication method requires in its
+// credentials payload.
+var credentialKeys = map[string][]string{
+	domain.AuthMethodUsername:   {"username", "password"},
+	domain.AuthMethodEmail:      {"email", "password"},
+	domain.AuthMethodEmployeeID: {"employeeId", "password"},
+}
+
+// resolveAuthenticate handles the authenticate mutation. The credentials
+// payload must carry the keys required by the chosen method.
+func (r *Resolver) resolveAuthenticate(p graphql.ResolveParams) (any, error) {
+	method, _ := p.Args["method"].(string)
+	credentials, err := jsonObject("credentials", p.Args["credentials"])
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range credentialKeys[method] {
+		if _, ok := credentials[key]; !ok {
+			return nil, fmt.Errorf("credentials for method %q must include %q", method, key)
+		}
+	}
+	user, err := r.users.AuthenticateFromContext(p.Context, method, credentials)
+	if err != nil {
+		return nil, err
+	}
+	token, err := r.tokens.IssueToken(user)
+	if err != nil {
+		return nil, err
+	}
+	rememberMe, _ := p.Args["rememberMe"].(bool)
+	refreshToken, err := r.tokens.IssueRefreshToken(user, rememberMe)
+	if err != nil {
+		return nil, err
+	}
+	setAuthCookie(p.Context, token)
+	return map[string]any{"token": token, "refreshToken": refreshToken, "user": user.Clone()}, nil
+}
+
+// resolveCreateEmployee handles the createEmployee mutation.
+func (r *Resolver) resolveCreateEmployee(p graphql.ResolveParams) (any, error) {
+	if _, err := requireRole(p.Context, domain.RoleAdmin, domain.RoleHR); err != nil {
+		return nil, err
+	}
+	input, ok := p.Args["input"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("input must be an object")
+	}
+	employee, err := employeeFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	idempotencyKey, _ := p.Args["idempotencyKey"].(string)
+	return r.employees.CreateEmployeeFromContext(p.Context, employee, idempotencyKey)
+}
+
+// resolveUpdateMyProfile handles the updateMyProfile mutation, letting the
+// authenticated user edit the self-editable fields of their own record.
+func (r *Resolver) resolveUpdateMyProfile(p graphql.ResolveParams) (any, error) {
+	if _, err := requireAuth(p.Context); err != nil {
+		return nil, err
+	}
+	updates, err := jsonObject("input", p.Args["input"])
+	if err != nil {
+		return nil, err
+	}
+	return r.employees.UpdateMyProfileFromContext(p.Context, updates)
+}
+
+// resolveUpdateEmployee handles the updateEmployee mutation.
+func (r *Resolver) resolveUpdateEmployee(p graphql.ResolveParams) (any, error) {
+	if _, err := requireRole(p.Context, domain.RoleAdmin, domain.RoleHR); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee id")
+	}
+	updates, err := jsonObject("input", p.Args["input"])
+	if err != nil {
+		return nil, err
+	}
+	return r.employees.UpdateEmployeeFromContext(p.Context, id, updates)
+}
+
+// resolveDeleteEmployee handles the deleteEmployee mutation.
+func (r *Resolver) resolveDeleteEmployee(p graphql.ResolveParams) (any, error) {
+	if _, err := requireRole(p.Context, domain.RoleAdmin); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee id")
+	}
+	if err := r.employees.DeleteEmployeeFromContext(p.Context, id); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// resolveUpdateSalary handles the updateSalary mutation.
+func (r *Resolver) resolveUpdateSalary(p graphql.ResolveParams) (any, error) {
+	if _, err := requireRole(p.Context, domain.RoleAdmin, domain.RoleHR); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee id")
+	}
+	salary, ok := p.Args["salary"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("salary must be a number")
+	}
+	return r.employees.UpdateSalaryFromContext(p.Context, id, salary)
+}
+
+// resolveChangePassword handles the changePassword mutation for the caller.
+func (r *Resolver) resolveChangePassword(p graphql.ResolveParams) (any, error) {
+	if _, err := requireAuth(p.Context); err != nil {
+		return nil, err
+	}
+	current, _ := p.Args["currentPassword"].(string)
+	newPassword, _ := p.Args["newPassword"].(string)
+	if err := r.users.ChangePasswordFromContext(p.Context, current, newPassword); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+// employeeFromInput converts a createEmployee input map into a validated
+// domain employee.
+func employeeFromInput(input map[string]any) (*domain.Employee, error) {
+	firstName, _ := input["firstName"].(string)
+	lastName, _ := input["lastName"].(string)
+	email, _ := input["email"].(string)
+	phone, _ := input["phone"].(string)
+	department, _ := input["department"].(string)
+	position, _ := input["position"].(string)
+	salary, _ := input["salary"].(float64)
+
+	var hireDate time.Time
+	switch v := input["hireDate"].(type) {
+	case time.Time:
+		hireDate = v
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			parsed, err = time.Parse("2006-01-02", v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid hire date")
+			}
+		}
+		hireDate = parsed
+	}
+
+	var managerID *uuid.UUID
+	if s, ok := input["managerId"].(string); ok && s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid manager id")
+		}
+		managerID = &id
+	}
+
+	employee, err := domain.NewEmployee(firstName, lastName, email, phone, department, position, salary, hireDate, managerID)
+	if err != nil {
+		return nil, err
+	}
+	if addr, ok := input["address"].(map[string]any); ok {
+		address := &domain.Address{}
+		if v, ok := addr["street"].(string); ok {
+			address.Street = v
+		}
+		if v, ok := addr["city"].(string); ok {
+			address.City = v
+		}
+		if v, ok := addr["state"].(string); ok {
+			address.State = v
+		}
+		if v, ok := addr["postalCode"].(string); ok {
+			address.PostalCode = v
+		}
+		if v, ok := addr["country"].(string); ok {
+			address.Country = v
+		}
+		employee.Address = address
+	}
+	if v, ok := input["salaryCurrency"].(string); ok && v != "" {
+		employee.SalaryCurrency = strings.ToUpper(v)
+		if !domain.IsValidCurrency(employee.SalaryCurrency) {
+			return nil, fmt.Errorf("unknown salary currency: %s", v)
+		}
+	} else {
+		// Re-derive now that the address is attached, so the country
+		// default applies.
+		employee.SalaryCurrency = ""
+		employee.EnsureSalaryCurrency()
+	}
+	return employee, nil
+}
+
+// parseEmployeeFilter converts the filter argument into a domain filter.
+func parseEmployeeFilter(arg any) domain.EmployeeFilter {
+	var filter domain.EmployeeFilter
+	m, ok := arg.(map[string]any)
+	if !ok {
+		return filter
+	}
+	if v, ok := m["department"].(string); ok {
+		filter.Department = v
+	}
+	if v, ok := m["position"].(string); ok {
+		filter.Position = v
+	}
+	if v, ok := m["status"].(string); ok {
+		filter.Status = domain.EmployeeStatus(v)
+	}
+	if v, ok := m["search"].(string); ok {
+		filter.Search = v
+	}
+	if v, ok := m["managerId"].(string); ok {
+		if id, err := uuid.Parse(v); err == nil {
+			filter.ManagerID = &id
+		}
+	}
+	return filter
+}
+
+// parseEmployeeSort converts the sort argument into a domain sort.
+func parseEmployeeSort(arg any) domain.EmployeeSort {
+	var sort domain.EmployeeSort
+	m, ok := arg.(map[string]any)
+	if !ok {
+		return sort
+	}
+	if v, ok := m["field"].(string); ok {
+		sort.Field = v
+	}
+	if v, ok := m["direction"].(string); ok {
+		sort.Direction = domain.SortDirection(v)
+	}
+	return sort
+}
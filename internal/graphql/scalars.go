@@ -0,0 +1,99 @@
+// Package graphql exposes the domain services over a GraphQL HTTP API.
+package graphql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// dateTimeScalar serializes time.Time values as RFC 3339 strings. All
+// values pass through UTC in both directions so the API never leaks the
+// server's local timezone and parsed inputs are stored normalized.
+var dateTimeScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "DateTime",
+	Description: "An RFC 3339 timestamp, always rendered in UTC.",
+	Serialize: func(value any) any {
+		switch t := value.(type) {
+		case time.Time:
+			return t.UTC().Format(time.RFC3339)
+		case *time.Time:
+			if t == nil {
+				return nil
+			}
+			return t.UTC().Format(time.RFC3339)
+		}
+		return nil
+	},
+	ParseValue: func(value any) any {
+		if s, ok := value.(string); ok {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				return t.UTC()
+			}
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		if s, ok := valueAST.(*ast.StringValue); ok {
+			if t, err := time.Parse(time.RFC3339, s.Value); err == nil {
+				return t.UTC()
+			}
+		}
+		return nil
+	},
+})
+
+// jsonScalar passes arbitrary JSON values through unmodified. Validation of
+// the payload shape is left to the consuming resolver.
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Json",
+	Description: "An arbitrary JSON value.",
+	Serialize: func(value any) any {
+		return value
+	},
+	ParseValue: func(value any) any {
+		return value
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		return parseJSONLiteral(valueAST)
+	},
+})
+
+// parseJSONLiteral converts an inline GraphQL literal into plain Go values.
+func parseJSONLiteral(valueAST ast.Value) any {
+	switch v := valueAST.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		return v.Value
+	case *ast.FloatValue:
+		return v.Value
+	case *ast.ObjectValue:
+		obj := make(map[string]any, len(v.Fields))
+		for _, f := range v.Fields {
+			obj[f.Name.Value] = parseJSONLiteral(f.Value)
+		}
+		return obj
+	case *ast.ListValue:
+		list := make([]any, 0, len(v.Values))
+		for _, item := range v.Values {
+			list = append(list, parseJSONLiteral(item))
+		}
+		return list
+	}
+	return nil
+}
+
+// jsonObject coerces a Json scalar argument into a map, naming the argument
+// in the error so clients see which value was not an object.
+func jsonObject(name string, value any) (map[string]any, error) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a JSON object, got %T", name, value)
+	}
+	return obj, nil
+}
@@ -0,0 +1,330 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// NewSchema builds the executable GraphQL schema over the given resolver.
+func NewSchema(r *Resolver) (graphql.Schema, error) {
+	addressType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Address",
+		Fields: graphql.Fields{
+			"street":     &graphql.Field{Type: graphql.String},
+			"city":       &graphql.Field{Type: graphql.String},
+			"state":      &graphql.Field{Type: graphql.String},
+			"postalCode": &graphql.Field{Type: graphql.String},
+			"country":    &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	employeeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Employee",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"firstName": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"lastName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"fullName": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*domain.Employee).DisplayName(), nil
+				},
+			},
+			"email":          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"phone":          &graphql.Field{Type: graphql.String},
+			"department":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"position":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"salary":         &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"salaryCurrency": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"hireDate":       &graphql.Field{Type: graphql.NewNonNull(dateTimeScalar)},
+			"status":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"employmentType": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"address":        &graphql.Field{Type: addressType},
+			"version":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"createdAt":      &graphql.Field{Type: graphql.NewNonNull(dateTimeScalar)},
+			"updatedAt":      &graphql.Field{Type: graphql.NewNonNull(dateTimeScalar)},
+		},
+	})
+	employeeType.AddFieldConfig("manager", &graphql.Field{
+		Type:    employeeType,
+		Resolve: r.resolveManager,
+	})
+	employeeType.AddFieldConfig("directReports", &graphql.Field{
+		Type:    graphql.NewList(employeeType),
+		Resolve: r.resolveDirectReports,
+	})
+
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"startCursor":     &graphql.Field{Type: graphql.String},
+			"endCursor":       &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	employeeEdgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmployeeEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(employeeType)},
+		},
+	})
+
+	employeeConnectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmployeeConnection",
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(employeeEdgeType))),
+				Resolve: resolveEmployeeEdges,
+			},
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(pageInfoType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*domain.EmployeeResult).PageInfo, nil
+				},
+			},
+			"totalCount": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*domain.EmployeeResult).TotalCount, nil
+				},
+			},
+			// Page numbers are only meaningful in offset mode; cursor
+			// requests get null and navigate through pageInfo instead.
+			"totalPages": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if result := p.Source.(*domain.EmployeeResult); result.TotalPages > 0 {
+						return result.TotalPages, nil
+					}
+					return nil, nil
+				},
+			},
+			"currentPage": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if result := p.Source.(*domain.EmployeeResult); result.CurrentPage > 0 {
+						return result.CurrentPage, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"username":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"email":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"role":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"isActive":  &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"lastLogin": &graphql.Field{Type: dateTimeScalar},
+			"createdAt": &graphql.Field{Type: graphql.NewNonNull(dateTimeScalar)},
+		},
+	})
+
+	authPayloadType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AuthPayload",
+		Fields: graphql.Fields{
+			"token": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			// refreshToken carries the refresh credential; its lifetime is
+			// extended when the login asked to be remembered.
+			"refreshToken": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"user":         &graphql.Field{Type: graphql.NewNonNull(userType)},
+		},
+	})
+
+	employeeFilterInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EmployeeFilterInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"department": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"position":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"status":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"managerId":  &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"search":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	employeeSortInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EmployeeSortInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"direction": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	addressInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "AddressInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"street":     &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"city":       &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"state":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"postalCode": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"country":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+		},
+	})
+
+	employeeInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EmployeeInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"firstName":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"lastName":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"email":          &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"phone":          &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"department":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"position":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"salary":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"salaryCurrency": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"hireDate":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"managerId":      &graphql.InputObjectFieldConfig{Type: graphql.ID},
+			"address":        &graphql.InputObjectFieldConfig{Type: addressInput},
+		},
+	})
+
+	positionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Position",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"title":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"level":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"departmentId": &graphql.Field{Type: graphql.ID},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"me": &graphql.Field{
+				Type:    graphql.NewNonNull(userType),
+				Resolve: r.resolveMe,
+			},
+			"myEmployee": &graphql.Field{
+				Type:    employeeType,
+				Resolve: r.resolveMyEmployee,
+			},
+			"employee": &graphql.Field{
+				Type: employeeType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.resolveEmployee,
+			},
+			"employees": &graphql.Field{
+				Type: graphql.NewNonNull(employeeConnectionType),
+				Args: graphql.FieldConfigArgument{
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int},
+					"after":  &graphql.ArgumentConfig{Type: graphql.String},
+					"filter": &graphql.ArgumentConfig{Type: employeeFilterInput},
+					"sort":   &graphql.ArgumentConfig{Type: employeeSortInput},
+				},
+				Resolve: r.resolveEmployees,
+			},
+			"positions": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(positionType))),
+				Resolve: r.resolvePositions,
+			},
+			"validateEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(jsonScalar)},
+				},
+				Resolve: r.resolveValidateEmployee,
+			},
+		},
+	})
+
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"authenticate": &graphql.Field{
+				Type: graphql.NewNonNull(authPayloadType),
+				Args: graphql.FieldConfigArgument{
+					"method":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"credentials": &graphql.ArgumentConfig{Type: graphql.NewNonNull(jsonScalar)},
+					"rememberMe":  &graphql.ArgumentConfig{Type: graphql.Boolean},
+				},
+				Resolve: r.resolveAuthenticate,
+			},
+			"createEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"input":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(employeeInput)},
+					"idempotencyKey": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.resolveCreateEmployee,
+			},
+			"updateMyProfile": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(jsonScalar)},
+				},
+				Resolve: r.resolveUpdateMyProfile,
+			},
+			"updateEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(jsonScalar)},
+				},
+				Resolve: r.resolveUpdateEmployee,
+			},
+			"deleteEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.resolveDeleteEmployee,
+			},
+			"updateSalary": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"id":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"salary": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Float)},
+				},
+				Resolve: r.resolveUpdateSalary,
+			},
+			"changePassword": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"currentPassword": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"newPassword":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.resolveChangePassword,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    queryType,
+		Mutation: mutationType,
+	})
+}
+
+// employeeEdge pairs an employee with its pagination cursor.
+type employeeEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *domain.Employee `json:"node"`
+}
+
+// resolveEmployeeEdges derives the edge list, assigning each node an
+// offset-based cursor continuing from the page's start cursor.
+func resolveEmployeeEdges(p graphql.ResolveParams) (any, error) {
+	result := p.Source.(*domain.EmployeeResult)
+	start := 0
+	if result.PageInfo.StartCursor != "" {
+		if decoded, err := domain.DecodeCursor(result.PageInfo.StartCursor); err == nil {
+			start = decoded
+		}
+	}
+	edges := make([]employeeEdge, 0, len(result.Employees))
+	for i, e := range result.Employees {
+		edges = append(edges, employeeEdge{Cursor: domain.EncodeCursor(start + i), Node: e})
+	}
+	return edges, nil
+}
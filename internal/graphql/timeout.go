@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Default per-operation execution deadlines. Mutations get more headroom
+// than queries because writes fan out into audit logs and events.
+const (
+	DefaultQueryTimeout    = 10 * time.Second
+	DefaultMutationTimeout = 30 * time.Second
+)
+
+// operationTimeout returns the configured deadline for the operation the
+// request resolves to: the mutation timeout when it executes a mutation,
+// the query timeout otherwise.
+func (h *Handler) operationTimeout(query, operationName string) time.Duration {
+	if isMutation(query, operationName) {
+		return h.mutationTimeout
+	}
+	return h.queryTimeout
+}
+
+// withOperationTimeout bounds the context with the operation's deadline.
+// A non-positive timeout disables the bound.
+func (h *Handler) withOperationTimeout(ctx context.Context, query, operationName string) (context.Context, context.CancelFunc) {
+	timeout := h.operationTimeout(query, operationName)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// isMutation reports whether the request would execute a mutation.
+// Queries that fail to parse are treated as queries; the executor reports
+// the syntax error either way.
+func isMutation(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(query)})})
+	if err != nil {
+		return false
+	}
+	for _, def := range doc.Definitions {
+		operation, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName != "" && (operation.Name == nil || operation.Name.Value != operationName) {
+			continue
+		}
+		if operation.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+// Package lifecycle coordinates startup and graceful shutdown of the
+// long-running components of the application: the HTTP server and any
+// background workers such as event publishers or outbox relays.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Runner is a long-running component managed by the Lifecycle. Start must
+// return promptly after the component is running; Stop must drain in-flight
+// work and return before the context deadline.
+type Runner interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+// RunnerFunc adapts a pair of functions into a Runner. Either may be nil.
+type RunnerFunc struct {
+	StartFunc func(ctx context.Context) error
+	StopFunc  func(ctx context.Context) error
+}
+
+func (r RunnerFunc) Start(ctx context.Context) error {
+	if r.StartFunc == nil {
+		return nil
+	}
+	return r.StartFunc(ctx)
+}
+
+func (r RunnerFunc) Stop(ctx context.Context) error {
+	if r.StopFunc == nil {
+		return nil
+	}
+	return r.StopFunc(ctx)
+}
+
+// Lifecycle starts registered runners in order and stops them in reverse
+// order, so dependents shut down before their dependencies.
+type Lifecycle struct {
+	mu      sync.Mutex
+	runners []Runner
+	started []Runner
+}
+
+// New creates an empty Lifecycle.
+func New() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// Add registers a runner. Runners start in registration order.
+func (l *Lifecycle) Add(r Runner) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.runners = append(l.runners, r)
+}
+
+// Start starts all runners in order. If any fails, the ones already
+// started are stopped in reverse order before the error is returned.
+func (l *Lifecycle) Start(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, r := range l.runners {
+		if err := r.Start(ctx); err != nil {
+			stopErr := l.stopLocked(ctx)
+			return errors.Join(err, stopErr)
+		}
+		l.started = append(l.started, r)
+	}
+	return nil
+}
+
+// Stop stops all started runners in reverse order, draining in-flight work.
+// All stop errors are collected and returned together.
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stopLocked(ctx)
+}
+
+func (l *Lifecycle) stopLocked(ctx context.Context) error {
+	var errs []error
+	for i := len(l.started) - 1; i >= 0; i-- {
+		if err := l.started[i].Stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	l.started = nil
+	return errors.Join(errs...)
+}
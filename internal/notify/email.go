@@ -0,0 +1,36 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+// EmailChannel delivers messages over SMTP.
+type EmailChannel struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewEmailChannel creates an EmailChannel sending through the server at
+// addr (host:port) as the given from address. auth may be nil for servers
+// that accept unauthenticated relay (local postfix, test containers).
+func NewEmailChannel(addr, from string, auth smtp.Auth) *EmailChannel {
+	return &EmailChannel{addr: addr, from: from, auth: auth}
+}
+
+// Send implements Channel.
+func (c *EmailChannel) Send(ctx context.Context, msg Message) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", c.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.Recipients, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Body)
+	if err := smtp.SendMail(c.addr, c.auth, c.from, msg.Recipients, []byte(b.String())); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Package notify renders and delivers notifications for employee
+// lifecycle events. A Service implements domain.Notifier by matching each
+// event type against a configured rule (recipients plus subject and body
+// templates) and handing the rendered message to a delivery channel such
+// as email.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// Message is a rendered notification ready for delivery.
+type Message struct {
+	Recipients []string
+	Subject    string
+	Body       string
+}
+
+// Channel delivers rendered messages: email, a chat webhook, and so on.
+type Channel interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// ChannelFunc adapts a plain function to the Channel interface.
+type ChannelFunc func(ctx context.Context, msg Message) error
+
+// Send implements Channel.
+func (f ChannelFunc) Send(ctx context.Context, msg Message) error {
+	return f(ctx, msg)
+}
+
+// Rule configures notifications for one event type: who is notified and
+// the templates used to render the message. Templates execute against the
+// event value, so fields like {{.Employee.Email}} are available for the
+// events that carry them.
+type Rule struct {
+	Recipients      []string
+	SubjectTemplate string
+	BodyTemplate    string
+}
+
+// rule is a Rule with its templates parsed.
+type rule struct {
+	recipients []string
+	subject    *template.Template
+	body       *template.Template
+}
+
+// Service implements domain.Notifier, rendering per-event-type rules and
+// sending the result through a channel. Events without a rule are ignored.
+type Service struct {
+	mu      sync.RWMutex
+	channel Channel
+	rules   map[string]rule
+}
+
+// NewService creates a Service delivering through the given channel.
+func NewService(channel Channel) *Service {
+	return &Service{channel: channel, rules: make(map[string]rule)}
+}
+
+// AddRule installs the rule for an event type, replacing any existing one.
+// It fails when a template does not parse or the rule has no recipients.
+func (s *Service) AddRule(eventType string, r Rule) error {
+	if len(r.Recipients) == 0 {
+		return fmt.Errorf("rule for %s has no recipients", eventType)
+	}
+	subject, err := template.New(eventType + ":subject").Parse(r.SubjectTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse subject template for %s: %w", eventType, err)
+	}
+	body, err := template.New(eventType + ":body").Parse(r.BodyTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse body template for %s: %w", eventType, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules[eventType] = rule{recipients: append([]string{}, r.Recipients...), subject: subject, body: body}
+	return nil
+}
+
+// EventTypes returns the event types the service has rules for, for
+// subscribing it to the dispatcher.
+func (s *Service) EventTypes() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	types := make([]string, 0, len(s.rules))
+	for eventType := range s.rules {
+		types = append(types, eventType)
+	}
+	return types
+}
+
+// Notify implements domain.Notifier: events with a rule are rendered and
+// sent, all others are silently ignored.
+func (s *Service) Notify(ctx context.Context, event domain.DomainEvent) error {
+	s.mu.RLock()
+	r, ok := s.rules[event.EventType()]
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	var subject, body strings.Builder
+	if err := r.subject.Execute(&subject, event); err != nil {
+		return fmt.Errorf("failed to render subject for %s: %w", event.EventType(), err)
+	}
+	if err := r.body.Execute(&body, event); err != nil {
+		return fmt.Errorf("failed to render body for %s: %w", event.EventType(), err)
+	}
+	return s.channel.Send(ctx, Message{
+		Recipients: r.recipients,
+		Subject:    subject.String(),
+		Body:       body.String(),
+	})
+}
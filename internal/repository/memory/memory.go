@@ -0,0 +1,950 @@
+// Package memory provides in-memory implementations of the domain
+// repository ports, used by the test suites and local experimentation.
+// They mirror the semantics of the Postgres repositories, including the
+// not-found contract (Get methods return the matching not-found sentinel,
+// Find methods return (nil, nil) for optional lookups), offset-cursor
+// pagination, and per-tenant scoping via domain.TenantVisible.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// EmployeeRepository is an in-memory domain.EmployeeRepository.
+type EmployeeRepository struct {
+	mu        sync.RWMutex
+	employees map[uuid.UUID]*domain.Employee
+}
+
+// NewEmployeeRepository creates an empty in-memory employee repository.
+func NewEmployeeRepository() *EmployeeRepository {
+	return &EmployeeRepository{employees: make(map[uuid.UUID]*domain.Employee)}
+}
+
+// Create stores a copy of the employee.
+func (r *EmployeeRepository) Create(ctx context.Context, e *domain.Employee) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.employees {
+		if existing.Email == e.Email {
+			return domain.ErrEmailAlreadyExists
+		}
+	}
+	stored := *e
+	r.employees[e.ID] = &stored
+	return nil
+}
+
+// GetByID returns a copy of the employee, or ErrEmployeeNotFound when
+// absent.
+func (r *EmployeeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.employees[id]
+	if !ok || !domain.TenantVisible(ctx, e.TenantID) {
+		return nil, domain.ErrEmployeeNotFound
+	}
+	return e.Clone(), nil
+}
+
+// Update replaces the stored employee, enforcing optimistic locking.
+func (r *EmployeeRepository) Update(ctx context.Context, e *domain.Employee) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, ok := r.employees[e.ID]
+	if !ok || !domain.TenantVisible(ctx, current.TenantID) {
+		return domain.ErrEmployeeNotFound
+	}
+	if current.Version != e.Version {
+		return domain.ErrConcurrentModification
+	}
+	stored := *e
+	stored.Version++
+	r.employees[e.ID] = &stored
+	e.Version = stored.Version
+	return nil
+}
+
+// Delete removes the employee.
+func (r *EmployeeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.employees[id]
+	if !ok || !domain.TenantVisible(ctx, e.TenantID) {
+		return domain.ErrEmployeeNotFound
+	}
+	delete(r.employees, id)
+	return nil
+}
+
+// List returns a filtered, sorted page of employees with a total count.
+func (r *EmployeeRepository) List(ctx context.Context, filter domain.EmployeeFilter, sortBy domain.EmployeeSort, pagination domain.Pagination) (*domain.EmployeeResult, error) {
+	r.mu.RLock()
+	var matched []*domain.Employee
+	for _, e := range r.employees {
+		if domain.TenantVisible(ctx, e.TenantID) && employeeMatches(e, filter) {
+			matched = append(matched, e.Clone())
+		}
+	}
+	r.mu.RUnlock()
+
+	// An empty sort falls back to the documented default so unsorted
+	// pagination is deterministic.
+	if sortBy.Field == "" {
+		sortBy = domain.DefaultEmployeeSort
+	}
+	sortEmployees(matched, sortBy)
+	total := len(matched)
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		decoded, err := domain.DecodeCursor(pagination.After)
+		if err != nil {
+			return nil, err
+		}
+		offset = decoded + 1
+	}
+	page := paginateEmployees(matched, offset, pagination.Limit)
+
+	result := &domain.EmployeeResult{Employees: page, TotalCount: total}
+	result.TotalPages, result.CurrentPage = pagination.PageNumbers(total)
+	if len(page) > 0 {
+		result.PageInfo = domain.PageInfo{
+			HasNextPage:     offset+len(page) < total,
+			HasPreviousPage: offset > 0,
+			StartCursor:     domain.EncodeCursor(offset),
+			EndCursor:       domain.EncodeCursor(offset + len(page) - 1),
+		}
+	}
+	return result, nil
+}
+
+// ListFields is List returning employees with only the named fields
+// populated, mirroring the Postgres column projection.
+func (r *EmployeeRepository) ListFields(ctx context.Context, filter domain.EmployeeFilter, sortBy domain.EmployeeSort, pagination domain.Pagination, fields []string) (*domain.EmployeeResult, error) {
+	result, err := r.List(ctx, filter, sortBy, pagination)
+	if err != nil || fields == nil {
+		return result, err
+	}
+	for i, e := range result.Employees {
+		result.Employees[i] = projectEmployee(e, fields)
+	}
+	return result, nil
+}
+
+// projectEmployee copies only the named fields onto a fresh employee;
+// ID, tenant, and version are always kept and unknown names are ignored.
+func projectEmployee(e *domain.Employee, fields []string) *domain.Employee {
+	projected := &domain.Employee{ID: e.ID, TenantID: e.TenantID, Version: e.Version}
+	for _, field := range fields {
+		switch field {
+		case "firstName":
+			projected.FirstName = e.FirstName
+		case "lastName":
+			projected.LastName = e.LastName
+		case "email":
+			projected.Email = e.Email
+		case "phone":
+			projected.Phone = e.Phone
+		case "department":
+			projected.Department = e.Department
+		case "departmentId":
+			projected.DepartmentID = e.DepartmentID
+		case "position":
+			projected.Position = e.Position
+		case "salary":
+			projected.Salary = e.Salary
+		case "salaryCurrency":
+			projected.SalaryCurrency = e.SalaryCurrency
+		case "hireDate":
+			projected.HireDate = e.HireDate
+		case "status":
+			projected.Status = e.Status
+		case "employmentType":
+			projected.EmploymentType = e.EmploymentType
+		case "managerId":
+			projected.ManagerID = e.ManagerID
+		case "userId":
+			projected.UserID = e.UserID
+		case "address":
+			projected.Address = e.Address
+		case "createdAt":
+			projected.CreatedAt = e.CreatedAt
+		case "updatedAt":
+			projected.UpdatedAt = e.UpdatedAt
+		}
+	}
+	return projected
+}
+
+// StreamEmployees feeds a copy of every matching employee to fn, oldest
+// first. The snapshot is taken up front, so the callback may safely write
+// back through the repository. Iteration stops at the first callback
+// error, which is returned unchanged.
+func (r *EmployeeRepository) StreamEmployees(ctx context.Context, filter domain.EmployeeFilter, fn func(*domain.Employee) error) error {
+	r.mu.RLock()
+	var matched []*domain.Employee
+	for _, e := range r.employees {
+		if domain.TenantVisible(ctx, e.TenantID) && employeeMatches(e, filter) {
+			matched = append(matched, e.Clone())
+		}
+	}
+	r.mu.RUnlock()
+
+	sortEmployees(matched, domain.EmployeeSort{Field: "createdAt", Direction: domain.SortAsc})
+	for _, e := range matched {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByIDs returns copies of all stored employees whose IDs are in the
+// given set. Missing IDs are absent from the result; order is unspecified.
+func (r *EmployeeRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var employees []*domain.Employee
+	for _, id := range ids {
+		if e, ok := r.employees[id]; ok && domain.TenantVisible(ctx, e.TenantID) {
+			employees = append(employees, e.Clone())
+		}
+	}
+	return employees, nil
+}
+
+// ReassignManager moves every direct report of fromManagerID under
+// toManagerID.
+func (r *EmployeeRepository) ReassignManager(ctx context.Context, fromManagerID, toManagerID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.employees {
+		if e.ManagerID != nil && *e.ManagerID == fromManagerID && domain.TenantVisible(ctx, e.TenantID) {
+			to := toManagerID
+			e.ManagerID = &to
+			e.Version++
+			e.UpdatedAt = time.Now()
+		}
+	}
+	return nil
+}
+
+// Count returns the number of stored employees matching the filter.
+func (r *EmployeeRepository) Count(ctx context.Context, filter domain.EmployeeFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	total := 0
+	for _, e := range r.employees {
+		if domain.TenantVisible(ctx, e.TenantID) && employeeMatches(e, filter) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// Suggest returns employees whose first or last name starts with the
+// prefix, compared case-insensitively and sorted by name.
+func (r *EmployeeRepository) Suggest(ctx context.Context, prefix string, limit int) ([]*domain.Employee, error) {
+	r.mu.RLock()
+	lower := strings.ToLower(prefix)
+	var matched []*domain.Employee
+	for _, e := range r.employees {
+		if !domain.TenantVisible(ctx, e.TenantID) {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(e.FirstName), lower) ||
+			strings.HasPrefix(strings.ToLower(e.LastName), lower) {
+			matched = append(matched, e.Clone())
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].LastName != matched[j].LastName {
+			return matched[i].LastName < matched[j].LastName
+		}
+		if matched[i].FirstName != matched[j].FirstName {
+			return matched[i].FirstName < matched[j].FirstName
+		}
+		return matched[i].ID.String() < matched[j].ID.String()
+	})
+	if limit > 0 && len(matched) > limit {
+		matched = matched[:limit]
+	}
+	return matched, nil
+}
+
+// ExistsInDepartment reports whether any stored employee belongs to the
+// department.
+func (r *EmployeeRepository) ExistsInDepartment(ctx context.Context, department string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, e := range r.employees {
+		if e.Department == department && domain.TenantVisible(ctx, e.TenantID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExistsByEmail reports whether any stored employee uses the email,
+// compared case-insensitively.
+func (r *EmployeeRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, e := range r.employees {
+		if strings.EqualFold(e.Email, email) && domain.TenantVisible(ctx, e.TenantID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FindByEmail returns a copy of the employee with the given email,
+// compared case-insensitively, or (nil, nil) when absent.
+func (r *EmployeeRepository) FindByEmail(ctx context.Context, email string) (*domain.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, e := range r.employees {
+		if strings.EqualFold(e.Email, email) && domain.TenantVisible(ctx, e.TenantID) {
+			return e.Clone(), nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByUserID returns the employee linked to the given user account, or
+// nil when no employee holds the link.
+func (r *EmployeeRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*domain.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, e := range r.employees {
+		if e.UserID != nil && *e.UserID == userID && domain.TenantVisible(ctx, e.TenantID) {
+			return e.Clone(), nil
+		}
+	}
+	return nil, nil
+}
+
+// ListByManager returns all employees reporting directly to the manager.
+func (r *EmployeeRepository) ListByManager(ctx context.Context, managerID uuid.UUID) ([]*domain.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var reports []*domain.Employee
+	for _, e := range r.employees {
+		if e.ManagerID != nil && *e.ManagerID == managerID && domain.TenantVisible(ctx, e.TenantID) {
+			reports = append(reports, e.Clone())
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].LastName != reports[j].LastName {
+			return reports[i].LastName < reports[j].LastName
+		}
+		return reports[i].FirstName < reports[j].FirstName
+	})
+	return reports, nil
+}
+
+func employeeMatches(e *domain.Employee, filter domain.EmployeeFilter) bool {
+	if filter.Department != "" && e.Department != filter.Department {
+		return false
+	}
+	if filter.Position != "" && e.Position != filter.Position {
+		return false
+	}
+	if filter.Status != "" && e.Status != filter.Status {
+		return false
+	}
+	if filter.ManagerID != nil && (e.ManagerID == nil || *e.ManagerID != *filter.ManagerID) {
+		return false
+	}
+	if filter.Search != "" {
+		needle := strings.ToLower(filter.Search)
+		haystack := strings.ToLower(e.FullName() + " " + e.Email)
+		if !strings.Contains(haystack, needle) {
+			return false
+		}
+	}
+	if filter.HiredAfter != nil && !e.HireDate.After(*filter.HiredAfter) {
+		return false
+	}
+	return true
+}
+
+func sortEmployees(employees []*domain.Employee, sortBy domain.EmployeeSort) {
+	desc := sortBy.Direction == domain.SortDesc
+	sort.SliceStable(employees, func(i, j int) bool {
+		a, b := employees[i], employees[j]
+		var less, equal bool
+		switch sortBy.Field {
+		case "firstName":
+			less, equal = a.FirstName < b.FirstName, a.FirstName == b.FirstName
+		case "lastName":
+			less, equal = a.LastName < b.LastName, a.LastName == b.LastName
+		case "email":
+			less, equal = a.Email < b.Email, a.Email == b.Email
+		case "hireDate":
+			less, equal = a.HireDate.Before(b.HireDate), a.HireDate.Equal(b.HireDate)
+		case "salary":
+			less, equal = a.Salary < b.Salary, a.Salary == b.Salary
+		default:
+			less, equal = a.CreatedAt.Before(b.CreatedAt), a.CreatedAt.Equal(b.CreatedAt)
+		}
+		// Equal keys tie-break on ID so the order is deterministic even
+		// though the backing map iterates randomly.
+		if equal {
+			less = a.ID.String() < b.ID.String()
+		}
+		if desc {
+			return !less
+		}
+		return less
+	})
+}
+
+func paginateEmployees(employees []*domain.Employee, offset, limit int) []*domain.Employee {
+	if offset >= len(employees) {
+		return nil
+	}
+	end := len(employees)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return employees[offset:end]
+}
+
+// DepartmentRepository is an in-memory domain.DepartmentRepository.
+type DepartmentRepository struct {
+	mu          sync.RWMutex
+	departments map[uuid.UUID]*domain.Department
+}
+
+// NewDepartmentRepository creates an empty in-memory department repository.
+func NewDepartmentRepository() *DepartmentRepository {
+	return &DepartmentRepository{departments: make(map[uuid.UUID]*domain.Department)}
+}
+
+// Create stores a copy of the department.
+func (r *DepartmentRepository) Create(ctx context.Context, d *domain.Department) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *d
+	r.departments[d.ID] = &stored
+	return nil
+}
+
+// GetByID returns a copy of the department, or ErrDepartmentNotFound when
+// absent.
+func (r *DepartmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, ok := r.departments[id]
+	if !ok {
+		return nil, domain.ErrDepartmentNotFound
+	}
+	clone := *d
+	return &clone, nil
+}
+
+// FindByName returns a copy of the department with the given name, compared
+// case-insensitively, or (nil, nil) when absent.
+func (r *DepartmentRepository) FindByName(ctx context.Context, name string) (*domain.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, d := range r.departments {
+		if strings.EqualFold(d.Name, name) {
+			clone := *d
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+// List returns all departments ordered by name.
+func (r *DepartmentRepository) List(ctx context.Context) ([]*domain.Department, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	departments := make([]*domain.Department, 0, len(r.departments))
+	for _, d := range r.departments {
+		clone := *d
+		departments = append(departments, &clone)
+	}
+	sort.Slice(departments, func(i, j int) bool {
+		if departments[i].Name != departments[j].Name {
+			return departments[i].Name < departments[j].Name
+		}
+		return departments[i].ID.String() < departments[j].ID.String()
+	})
+	return departments, nil
+}
+
+// PositionRepository is an in-memory domain.PositionRepository.
+type PositionRepository struct {
+	mu        sync.RWMutex
+	positions map[uuid.UUID]*domain.Position
+}
+
+// NewPositionRepository creates an empty in-memory position repository.
+func NewPositionRepository() *PositionRepository {
+	return &PositionRepository{positions: make(map[uuid.UUID]*domain.Position)}
+}
+
+// Create stores a copy of the position.
+func (r *PositionRepository) Create(ctx context.Context, p *domain.Position) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *p
+	r.positions[p.ID] = &stored
+	return nil
+}
+
+// GetByID returns a copy of the position, or ErrPositionNotFound when
+// absent.
+func (r *PositionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Position, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.positions[id]
+	if !ok {
+		return nil, domain.ErrPositionNotFound
+	}
+	clone := *p
+	return &clone, nil
+}
+
+// FindByTitle returns a copy of the position with the given title, compared
+// case-insensitively, or (nil, nil) when absent.
+func (r *PositionRepository) FindByTitle(ctx context.Context, title string) (*domain.Position, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.positions {
+		if strings.EqualFold(p.Title, title) {
+			clone := *p
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+// List returns all positions ordered by level then title.
+func (r *PositionRepository) List(ctx context.Context) ([]*domain.Position, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	positions := make([]*domain.Position, 0, len(r.positions))
+	for _, p := range r.positions {
+		clone := *p
+		positions = append(positions, &clone)
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Level != positions[j].Level {
+			return positions[i].Level < positions[j].Level
+		}
+		if positions[i].Title != positions[j].Title {
+			return positions[i].Title < positions[j].Title
+		}
+		return positions[i].ID.String() < positions[j].ID.String()
+	})
+	return positions, nil
+}
+
+// UserRepository is an in-memory domain.UserRepository.
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[uuid.UUID]*domain.User
+}
+
+// NewUserRepository creates an empty in-memory user repository.
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: make(map[uuid.UUID]*domain.User)}
+}
+
+// Create stores a copy of the user, rejecting usernames that collide
+// case-insensitively with an existing account.
+func (r *UserRepository) Create(ctx context.Context, u *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Username, u.Username) {
+			return domain.ErrUsernameAlreadyExists
+		}
+	}
+	stored := *u
+	r.users[u.ID] = &stored
+	return nil
+}
+
+// GetByID returns a copy of the user, or ErrUserNotFound when absent.
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	u, ok := r.users[id]
+	if !ok || !domain.TenantVisible(ctx, u.TenantID) {
+		return nil, domain.ErrUserNotFound
+	}
+	clone := *u
+	return &clone, nil
+}
+
+// FindByUsername returns a copy of the user matching the username
+// case-insensitively, or (nil, nil) when absent.
+func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, u := range r.users {
+		if strings.EqualFold(u.Username, username) && domain.TenantVisible(ctx, u.TenantID) {
+			clone := *u
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindByEmail returns a copy of the user with the given email, compared
+// case-insensitively, or (nil, nil) when absent.
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email, email) && domain.TenantVisible(ctx, u.TenantID) {
+			clone := *u
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+// Update replaces the stored user, enforcing optimistic locking.
+func (r *UserRepository) Update(ctx context.Context, u *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, ok := r.users[u.ID]
+	if !ok || !domain.TenantVisible(ctx, current.TenantID) {
+		return domain.ErrUserNotFound
+	}
+	if current.Version != u.Version {
+		return domain.ErrConcurrentModification
+	}
+	stored := *u
+	stored.Version++
+	r.users[u.ID] = &stored
+	u.Version = stored.Version
+	return nil
+}
+
+// List returns a filtered page of users with a total count.
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter, pagination domain.Pagination) (*domain.UserResult, error) {
+	r.mu.RLock()
+	var matched []*domain.User
+	for _, u := range r.users {
+		if domain.TenantVisible(ctx, u.TenantID) && userMatches(u, filter) {
+			clone := *u
+			matched = append(matched, &clone)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Username != matched[j].Username {
+			return matched[i].Username < matched[j].Username
+		}
+		return matched[i].ID.String() < matched[j].ID.String()
+	})
+	total := len(matched)
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		decoded, err := domain.DecodeCursor(pagination.After)
+		if err != nil {
+			return nil, err
+		}
+		offset = decoded + 1
+	}
+	var page []*domain.User
+	if offset < len(matched) {
+		end := len(matched)
+		if pagination.Limit > 0 && offset+pagination.Limit < end {
+			end = offset + pagination.Limit
+		}
+		page = matched[offset:end]
+	}
+
+	result := &domain.UserResult{Users: page, TotalCount: total}
+	result.TotalPages, result.CurrentPage = pagination.PageNumbers(total)
+	if len(page) > 0 {
+		result.PageInfo = domain.PageInfo{
+			HasNextPage:     offset+len(page) < total,
+			HasPreviousPage: offset > 0,
+			StartCursor:     domain.EncodeCursor(offset),
+			EndCursor:       domain.EncodeCursor(offset + len(page) - 1),
+		}
+	}
+	return result, nil
+}
+
+// ExistsByUsername reports whether any stored user has the username,
+// compared case-insensitively.
+func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, u := range r.users {
+		if strings.EqualFold(u.Username, username) && domain.TenantVisible(ctx, u.TenantID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func userMatches(u *domain.User, filter domain.UserFilter) bool {
+	if filter.Search != "" {
+		needle := strings.ToLower(filter.Search)
+		haystack := strings.ToLower(u.Username + " " + u.Email)
+		if !strings.Contains(haystack, needle) {
+			return false
+		}
+	}
+	if filter.Role != "" && u.Role != filter.Role {
+		return false
+	}
+	if filter.IsActive != nil && u.IsActive != *filter.IsActive {
+		return false
+	}
+	if filter.LastLoginSince != nil && (u.LastLogin == nil || u.LastLogin.Before(*filter.LastLoginSince)) {
+		return false
+	}
+	if filter.NeverLoggedIn && u.LastLogin != nil {
+		return false
+	}
+	return true
+}
+
+// AuditLogRepository is an in-memory domain.AuditLogRepository.
+type AuditLogRepository struct {
+	mu        sync.RWMutex
+	logs      []*domain.AuditLog
+	employees *EmployeeRepository
+}
+
+// NewAuditLogRepository creates an empty in-memory audit-log repository.
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{}
+}
+
+// SetEmployeeRepository wires the employee store used to annotate and
+// filter logs of since-deleted employees. Without it every referenced
+// entity is treated as existing.
+func (r *AuditLogRepository) SetEmployeeRepository(employees *EmployeeRepository) {
+	r.employees = employees
+}
+
+// entityDeleted reports whether the log references an employee that no
+// longer exists.
+func (r *AuditLogRepository) entityDeleted(ctx context.Context, log *domain.AuditLog) bool {
+	if r.employees == nil || log.EmployeeID == nil {
+		return false
+	}
+	_, err := r.employees.GetByID(ctx, *log.EmployeeID)
+	return errors.Is(err, domain.ErrEmployeeNotFound)
+}
+
+// Create appends a copy of the audit log.
+func (r *AuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *log
+	r.logs = append(r.logs, &stored)
+	return nil
+}
+
+// List returns a filtered page of audit logs, newest first.
+func (r *AuditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter, pagination domain.Pagination) ([]*domain.AuditLog, int, error) {
+	r.mu.RLock()
+	var matched []*domain.AuditLog
+	for _, log := range r.logs {
+		if !domain.TenantVisible(ctx, log.TenantID) || !auditMatches(log, filter) {
+			continue
+		}
+		deleted := r.entityDeleted(ctx, log)
+		if deleted && !filter.IncludeDeletedEntities {
+			continue
+		}
+		clone := *log
+		clone.EntityDeleted = deleted
+		matched = append(matched, &clone)
+	}
+	r.mu.RUnlock()
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if !matched[i].Timestamp.Equal(matched[j].Timestamp) {
+			return matched[i].Timestamp.After(matched[j].Timestamp)
+		}
+		return matched[i].ID.String() > matched[j].ID.String()
+	})
+	total := len(matched)
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		// Keyset cursor: skip everything at or ahead of the cursor position
+		// so inserts cannot shift already-fetched pages.
+		timestamp, id, err := domain.DecodeAuditCursor(pagination.After)
+		if err != nil {
+			return nil, 0, err
+		}
+		offset = 0
+		for offset < len(matched) {
+			log := matched[offset]
+			if log.Timestamp.Before(timestamp) ||
+				(log.Timestamp.Equal(timestamp) && log.ID.String() < id.String()) {
+				break
+			}
+			offset++
+		}
+	}
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := len(matched)
+	if pagination.Limit > 0 && offset+pagination.Limit < end {
+		end = offset + pagination.Limit
+	}
+	return matched[offset:end], total, nil
+}
+
+// CountByOperation returns the number of matching audit logs per operation
+// type.
+func (r *AuditLogRepository) CountByOperation(ctx context.Context, filter domain.AuditLogFilter) (map[string]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	counts := make(map[string]int)
+	for _, log := range r.logs {
+		if !domain.TenantVisible(ctx, log.TenantID) || !auditMatches(log, filter) {
+			continue
+		}
+		if !filter.IncludeDeletedEntities && r.entityDeleted(ctx, log) {
+			continue
+		}
+		counts[log.Operation]++
+	}
+	return counts, nil
+}
+
+// ScrubEmployeeSnapshots overwrites the given snapshot fields with the
+// anonymization tombstone in every log referencing the employee, keeping
+// the action records themselves intact.
+func (r *AuditLogRepository) ScrubEmployeeSnapshots(ctx context.Context, employeeID uuid.UUID, fields []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, log := range r.logs {
+		if log.EmployeeID == nil || *log.EmployeeID != employeeID || !domain.TenantVisible(ctx, log.TenantID) {
+			continue
+		}
+		for _, field := range fields {
+			if _, ok := log.OldValues[field]; ok {
+				log.OldValues[field] = domain.AnonymizedValue
+			}
+			if _, ok := log.NewValues[field]; ok {
+				log.NewValues[field] = domain.AnonymizedValue
+			}
+		}
+	}
+	return nil
+}
+
+func auditMatches(log *domain.AuditLog, filter domain.AuditLogFilter) bool {
+	if filter.UserID != nil && (log.UserID == nil || *log.UserID != *filter.UserID) {
+		return false
+	}
+	if filter.EmployeeID != nil && (log.EmployeeID == nil || *log.EmployeeID != *filter.EmployeeID) {
+		return false
+	}
+	if filter.Operation != "" && log.Operation != filter.Operation {
+		return false
+	}
+	if filter.From != nil && log.Timestamp.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && log.Timestamp.After(*filter.To) {
+		return false
+	}
+	if filter.IPAddress != "" && log.IPAddress != filter.IPAddress {
+		return false
+	}
+	if filter.UserAgentContains != "" &&
+		!strings.Contains(strings.ToLower(log.UserAgent), strings.ToLower(filter.UserAgentContains)) {
+		return false
+	}
+	return true
+}
+
+// EventStore is an in-memory domain.EventStoreRepository for tests and
+// local experimentation. It mirrors the Postgres store's semantics:
+// append-only, with a monotonically increasing sequence assigned to every
+// saved event and per-aggregate replay via LoadEvents.
+type EventStore struct {
+	mu      sync.RWMutex
+	events  []domain.DomainEvent
+	stored  []*domain.StoredEvent
+	nextSeq int64
+}
+
+// NewEventStore creates an empty in-memory event store.
+func NewEventStore() *EventStore {
+	return &EventStore{}
+}
+
+// SaveEvent appends the event, serializing it and assigning the next
+// sequence number.
+func (s *EventStore) SaveEvent(ctx context.Context, event domain.DomainEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextSeq++
+	s.events = append(s.events, event)
+	s.stored = append(s.stored, &domain.StoredEvent{
+		ID:          uuid.New(),
+		AggregateID: event.AggregateID(),
+		EventType:   event.EventType(),
+		Payload:     payload,
+		Sequence:    s.nextSeq,
+		OccurredAt:  event.OccurredAt(),
+	})
+	return nil
+}
+
+// LoadEvents returns all stored events for the aggregate in sequence
+// order, upcasting older payloads to the current schema for replay.
+func (s *EventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]*domain.StoredEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var events []*domain.StoredEvent
+	for _, e := range s.stored {
+		if e.AggregateID == aggregateID {
+			clone := *e
+			if err := domain.UpcastStoredEvent(&clone); err != nil {
+				return nil, err
+			}
+			events = append(events, &clone)
+		}
+	}
+	return events, nil
+}
+
+// Events returns a snapshot of all stored events, in append order.
+func (s *EventStore) Events() []domain.DomainEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]domain.DomainEvent{}, s.events...)
+}
@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// auditColumns is the select list shared by all audit-log queries.
+const auditColumns = `id, user_id, employee_id, operation, old_values, new_values, ip_address, user_agent, location, timestamp, request_id, tenant_id`
+
+// auditEntityDeletedColumn annotates each log with whether its referenced
+// employee has since been deleted; it is computed at query time.
+const auditEntityDeletedColumn = `(employee_id IS NOT NULL AND NOT EXISTS(
+	SELECT 1 FROM employees WHERE employees.id = audit_logs.employee_id))`
+
+// auditEntityExistsCondition keeps logs whose referenced employee still
+// exists (or that never referenced one).
+const auditEntityExistsCondition = `(employee_id IS NULL OR EXISTS(
+	SELECT 1 FROM employees WHERE employees.id = audit_logs.employee_id))`
+
+// AuditLogRepository is the Postgres implementation of
+// domain.AuditLogRepository. Every query is scoped to the tenant carried
+// in the context; see tenantCondition.
+type AuditLogRepository struct {
+	db *sql.DB
+}
+
+// NewAuditLogRepository creates an AuditLogRepository on the given handle.
+func NewAuditLogRepository(db *sql.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// Create inserts a new audit-log row.
+func (r *AuditLogRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	oldValues, err := marshalValues(log.OldValues)
+	if err != nil {
+		return err
+	}
+	newValues, err := marshalValues(log.NewValues)
+	if err != nil {
+		return err
+	}
+	location, err := marshalLocation(log.Location)
+	if err != nil {
+		return err
+	}
+	_, err = q(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO audit_logs (id, user_id, employee_id, operation, old_values, new_values, ip_address, user_agent, location, timestamp, request_id, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		log.ID, log.UserID, log.EmployeeID, log.Operation, oldValues, newValues,
+		log.IPAddress, log.UserAgent, location, log.Timestamp, log.RequestID, log.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit log: %w", err)
+	}
+	return nil
+}
+
+// List returns a filtered page of audit logs, newest first, with a total
+// count of matching rows.
+func (r *AuditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter, pagination domain.Pagination) ([]*domain.AuditLog, int, error) {
+	where, args := buildAuditWhere(ctx, filter)
+
+	var total int
+	if err := q(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_logs`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		// Keyset cursor: pages stay stable when newer rows are inserted
+		// ahead of the cursor position.
+		timestamp, id, err := domain.DecodeAuditCursor(pagination.After)
+		if err != nil {
+			return nil, 0, err
+		}
+		args = append(args, timestamp, id)
+		keyset := fmt.Sprintf("(timestamp, id) < ($%d, $%d)", len(args)-1, len(args))
+		if where == "" {
+			where = " WHERE " + keyset
+		} else {
+			where += " AND " + keyset
+		}
+		offset = 0
+	}
+
+	args = append(args, pagination.Limit, offset)
+	query := fmt.Sprintf(`SELECT %s, %s FROM audit_logs%s ORDER BY timestamp DESC, id DESC LIMIT $%d OFFSET $%d`,
+		auditColumns, auditEntityDeletedColumn, where, len(args)-1, len(args))
+	rows, err := q(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []*domain.AuditLog
+	for rows.Next() {
+		log, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		logs = append(logs, log)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to read audit logs: %w", err)
+	}
+	return logs, total, nil
+}
+
+// CountByOperation returns the number of matching audit logs per operation
+// type, aggregated in the database.
+func (r *AuditLogRepository) CountByOperation(ctx context.Context, filter domain.AuditLogFilter) (map[string]int, error) {
+	where, args := buildAuditWhere(ctx, filter)
+	rows, err := q(ctx, r.db).QueryContext(ctx,
+		`SELECT operation, COUNT(*) FROM audit_logs`+where+` GROUP BY operation`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count audit logs by operation: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var operation string
+		var count int
+		if err := rows.Scan(&operation, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan operation count: %w", err)
+		}
+		counts[operation] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read operation counts: %w", err)
+	}
+	return counts, nil
+}
+
+// ScrubEmployeeSnapshots overwrites the given snapshot fields with the
+// anonymization tombstone in every log referencing the employee. Only the
+// snapshot keys change; the rows themselves are preserved.
+func (r *AuditLogRepository) ScrubEmployeeSnapshots(ctx context.Context, employeeID uuid.UUID, fields []string) error {
+	for _, field := range fields {
+		for _, column := range []string{"old_values", "new_values"} {
+			args := []any{employeeID, field, domain.AnonymizedValue}
+			query := fmt.Sprintf(
+				`UPDATE audit_logs SET %[1]s = jsonb_set(%[1]s, ARRAY[$2], to_jsonb($3::text)) WHERE employee_id = $1 AND %[1]s ? $2 AND `,
+				column) + tenantCondition(ctx, &args)
+			if _, err := q(ctx, r.db).ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to scrub audit snapshots: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// buildAuditWhere translates the filter into a WHERE clause and args,
+// always including the tenant scope.
+func buildAuditWhere(ctx context.Context, filter domain.AuditLogFilter) (string, []any) {
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.UserID != nil {
+		conditions = append(conditions, "user_id = "+arg(*filter.UserID))
+	}
+	if filter.EmployeeID != nil {
+		conditions = append(conditions, "employee_id = "+arg(*filter.EmployeeID))
+	}
+	if filter.Operation != "" {
+		conditions = append(conditions, "operation = "+arg(filter.Operation))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "timestamp >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "timestamp <= "+arg(*filter.To))
+	}
+	if filter.IPAddress != "" {
+		conditions = append(conditions, "ip_address = "+arg(filter.IPAddress))
+	}
+	if filter.UserAgentContains != "" {
+		conditions = append(conditions, "user_agent ILIKE "+arg("%"+filter.UserAgentContains+"%"))
+	}
+	if !filter.IncludeDeletedEntities {
+		conditions = append(conditions, auditEntityExistsCondition)
+	}
+	conditions = append(conditions, tenantCondition(ctx, &args))
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// scanAuditLog reads one audit-log row.
+func scanAuditLog(row rowScanner) (*domain.AuditLog, error) {
+	var log domain.AuditLog
+	var oldValues, newValues, location []byte
+	err := row.Scan(&log.ID, &log.UserID, &log.EmployeeID, &log.Operation,
+		&oldValues, &newValues, &log.IPAddress, &log.UserAgent, &location, &log.Timestamp,
+		&log.RequestID, &log.TenantID, &log.EntityDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan audit log: %w", err)
+	}
+	if len(oldValues) > 0 {
+		if err := json.Unmarshal(oldValues, &log.OldValues); err != nil {
+			return nil, fmt.Errorf("failed to decode old values: %w", err)
+		}
+	}
+	if len(newValues) > 0 {
+		if err := json.Unmarshal(newValues, &log.NewValues); err != nil {
+			return nil, fmt.Errorf("failed to decode new values: %w", err)
+		}
+	}
+	if len(location) > 0 {
+		log.Location = &domain.GeoLocation{}
+		if err := json.Unmarshal(location, log.Location); err != nil {
+			return nil, fmt.Errorf("failed to decode location: %w", err)
+		}
+	}
+	// Severity is derived from the registry, not stored.
+	if info, ok := domain.LookupOperation(log.Operation); ok {
+		log.Severity = info.Severity
+	}
+	return &log, nil
+}
+
+// marshalLocation serializes the resolved location for the jsonb column,
+// keeping NULL when no location was resolved.
+func marshalLocation(location *domain.GeoLocation) ([]byte, error) {
+	if location == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode location: %w", err)
+	}
+	return data, nil
+}
+
+// marshalValues serializes a snapshot map for a jsonb column, keeping NULL
+// for absent snapshots.
+func marshalValues(values map[string]any) ([]byte, error) {
+	if values == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit values: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,42 @@
+// Package postgres contains the Postgres implementations of the domain
+// repository ports.
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the Postgres error code raised when an insert or
+// update breaks a unique constraint.
+const uniqueViolation = pq.ErrorCode("23505")
+
+// isUniqueViolation reports whether err is a unique-constraint violation
+// on a constraint or index whose name contains the given fragment.
+func isUniqueViolation(err error, nameFragment string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) || pqErr.Code != uniqueViolation {
+		return false
+	}
+	return strings.Contains(pqErr.Constraint, nameFragment)
+}
+
+// Open connects to Postgres and verifies the connection with a ping.
+func Open(databaseURL string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	db.SetMaxOpenConns(25)
+	db.SetMaxIdleConns(5)
+	db.SetConnMaxLifetime(5 * time.Minute)
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
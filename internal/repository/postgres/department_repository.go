@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// departmentColumns is the select list shared by all department queries.
+const departmentColumns = `id, name, parent_id, created_at, updated_at`
+
+// DepartmentRepository is the Postgres implementation of
+// domain.DepartmentRepository.
+type DepartmentRepository struct {
+	db *sql.DB
+}
+
+// NewDepartmentRepository creates a DepartmentRepository on the given handle.
+func NewDepartmentRepository(db *sql.DB) *DepartmentRepository {
+	return &DepartmentRepository{db: db}
+}
+
+// Create inserts a new department row.
+func (r *DepartmentRepository) Create(ctx context.Context, d *domain.Department) error {
+	_, err := q(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO departments (id, name, parent_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		d.ID, d.Name, d.ParentID, d.CreatedAt, d.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert department: %w", err)
+	}
+	return nil
+}
+
+// GetByID fetches a department by primary key, returning
+// domain.ErrDepartmentNotFound when no row matches.
+func (r *DepartmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Department, error) {
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+departmentColumns+` FROM departments WHERE id = $1`, id)
+	department, err := scanDepartment(row)
+	if err != nil {
+		return nil, err
+	}
+	if department == nil {
+		return nil, domain.ErrDepartmentNotFound
+	}
+	return department, nil
+}
+
+// FindByName fetches a department by name, case-insensitively, returning
+// (nil, nil) when no row matches.
+func (r *DepartmentRepository) FindByName(ctx context.Context, name string) (*domain.Department, error) {
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+departmentColumns+` FROM departments WHERE LOWER(name) = LOWER($1)`, name)
+	return scanDepartment(row)
+}
+
+// List returns all departments ordered by name.
+func (r *DepartmentRepository) List(ctx context.Context) ([]*domain.Department, error) {
+	rows, err := q(ctx, r.db).QueryContext(ctx,
+		`SELECT `+departmentColumns+` FROM departments ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list departments: %w", err)
+	}
+	defer rows.Close()
+
+	var departments []*domain.Department
+	for rows.Next() {
+		d, err := scanDepartment(rows)
+		if err != nil {
+			return nil, err
+		}
+		departments = append(departments, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read departments: %w", err)
+	}
+	return departments, nil
+}
+
+// scanDepartment reads one department row, returning (nil, nil) on
+// sql.ErrNoRows.
+func scanDepartment(row rowScanner) (*domain.Department, error) {
+	var d domain.Department
+	err := row.Scan(&d.ID, &d.Name, &d.ParentID, &d.CreatedAt, &d.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan department: %w", err)
+	}
+	return &d, nil
+}
@@ -0,0 +1,576 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// employeeColumns is the select list shared by all employee queries.
+const employeeColumns = `id, first_name, last_name, email, phone, department, department_id, position, salary,
+	salary_currency, hire_date, status, employment_type, manager_id, user_id, address, tenant_id, version, created_at, updated_at`
+
+// employeeSortColumns whitelists sortable fields to their SQL columns.
+var employeeSortColumns = map[string]string{
+	"firstName": "first_name",
+	"lastName":  "last_name",
+	"email":     "email",
+	"hireDate":  "hire_date",
+	"salary":    "salary",
+	"createdAt": "created_at",
+}
+
+// EmployeeRepository is the Postgres implementation of
+// domain.EmployeeRepository. Every query is scoped to the tenant carried
+// in the context; see tenantCondition.
+type EmployeeRepository struct {
+	db *sql.DB
+}
+
+// NewEmployeeRepository creates an EmployeeRepository on the given handle.
+func NewEmployeeRepository(db *sql.DB) *EmployeeRepository {
+	return &EmployeeRepository{db: db}
+}
+
+// Create inserts a new employee row.
+func (r *EmployeeRepository) Create(ctx context.Context, e *domain.Employee) error {
+	address, err := marshalAddress(e.Address)
+	if err != nil {
+		return err
+	}
+	_, err = q(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO employees (id, first_name, last_name, email, phone, department, department_id, position,
+			salary, salary_currency, hire_date, status, employment_type, manager_id, user_id, address, tenant_id, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`,
+		e.ID, e.FirstName, e.LastName, e.Email, e.Phone, e.Department, e.DepartmentID, e.Position,
+		e.Salary, e.SalaryCurrency, e.HireDate, e.Status, e.EmploymentType, e.ManagerID, e.UserID, address,
+		e.TenantID, e.Version, e.CreatedAt, e.UpdatedAt)
+	if err != nil {
+		// The service checks ExistsByEmail first, but two concurrent
+		// creates can both pass that check; the unique index is the
+		// authority, so translate its violation into the domain error.
+		if isUniqueViolation(err, "email") {
+			return domain.ErrEmailAlreadyExists
+		}
+		return fmt.Errorf("failed to insert employee: %w", err)
+	}
+	return nil
+}
+
+// GetByID fetches an employee by primary key, returning
+// domain.ErrEmployeeNotFound when no row matches.
+func (r *EmployeeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Employee, error) {
+	args := []any{id}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+employeeColumns+` FROM employees WHERE id = $1 AND `+tenantCondition(ctx, &args), args...)
+	employee, err := scanEmployee(row)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, domain.ErrEmployeeNotFound
+	}
+	return employee, nil
+}
+
+// GetByIDs fetches all employees whose IDs are in the given set with a
+// single query. Missing IDs are simply absent from the result, and the
+// result order is unspecified; callers needing input order re-sort by ID.
+func (r *EmployeeRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Employee, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	args := []any{pq.Array(ids)}
+	rows, err := q(ctx, r.db).QueryContext(ctx,
+		`SELECT `+employeeColumns+` FROM employees WHERE id = ANY($1) AND `+tenantCondition(ctx, &args), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get employees by ids: %w", err)
+	}
+	defer rows.Close()
+
+	var employees []*domain.Employee
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, e)
+	}
+	return employees, rows.Err()
+}
+
+// Update persists employee changes, enforcing optimistic locking on the
+// version column.
+func (r *EmployeeRepository) Update(ctx context.Context, e *domain.Employee) error {
+	address, err := marshalAddress(e.Address)
+	if err != nil {
+		return err
+	}
+	args := []any{e.FirstName, e.LastName, e.Email, e.Phone, e.Department,
+		e.DepartmentID, e.Position, e.Salary, e.SalaryCurrency, e.HireDate, e.Status, e.EmploymentType,
+		e.ManagerID, e.UserID, address, e.UpdatedAt, e.ID, e.Version}
+	result, err := q(ctx, r.db).ExecContext(ctx, `
+		UPDATE employees
+		SET first_name = $1, last_name = $2, email = $3, phone = $4, department = $5,
+			department_id = $6, position = $7, salary = $8, salary_currency = $9, hire_date = $10,
+			status = $11, employment_type = $12, manager_id = $13, user_id = $14, address = $15, version = version + 1, updated_at = $16
+		WHERE id = $17 AND version = $18 AND `+tenantCondition(ctx, &args), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update employee: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check update result: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrConcurrentModification
+	}
+	e.Version++
+	return nil
+}
+
+// Delete removes an employee row.
+func (r *EmployeeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := []any{id}
+	result, err := q(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM employees WHERE id = $1 AND `+tenantCondition(ctx, &args), args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete employee: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check delete result: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrEmployeeNotFound
+	}
+	return nil
+}
+
+// List returns a filtered, sorted page of employees with a total count.
+func (r *EmployeeRepository) List(ctx context.Context, filter domain.EmployeeFilter, sort domain.EmployeeSort, pagination domain.Pagination) (*domain.EmployeeResult, error) {
+	return r.list(ctx, filter, sort, pagination, nil)
+}
+
+// ListFields is List selecting only the named employee fields; see
+// domain.EmployeeRepository.
+func (r *EmployeeRepository) ListFields(ctx context.Context, filter domain.EmployeeFilter, sort domain.EmployeeSort, pagination domain.Pagination, fields []string) (*domain.EmployeeResult, error) {
+	return r.list(ctx, filter, sort, pagination, fields)
+}
+
+// list runs the listing query; a nil fields slice selects every column,
+// otherwise the select list is projected down to the requested fields.
+func (r *EmployeeRepository) list(ctx context.Context, filter domain.EmployeeFilter, sort domain.EmployeeSort, pagination domain.Pagination, fields []string) (*domain.EmployeeResult, error) {
+	where, args := buildEmployeeWhere(ctx, filter)
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM employees` + where
+	if err := q(ctx, r.db).QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count employees: %w", err)
+	}
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		decoded, err := domain.DecodeCursor(pagination.After)
+		if err != nil {
+			return nil, err
+		}
+		offset = decoded + 1
+	}
+
+	// An empty sort falls back to the documented default so unsorted
+	// pagination is deterministic.
+	if sort.Field == "" {
+		sort = domain.DefaultEmployeeSort
+	}
+	orderBy := "created_at"
+	if col, ok := employeeSortColumns[sort.Field]; ok {
+		orderBy = col
+	}
+	direction := "ASC"
+	if sort.Direction == domain.SortDesc {
+		direction = "DESC"
+	}
+
+	columns := employeeColumns
+	if fields != nil {
+		columns, fields = projectedEmployeeColumns(fields)
+	}
+
+	args = append(args, pagination.Limit, offset)
+	query := fmt.Sprintf(`SELECT %s FROM employees%s ORDER BY %s %s, id %s LIMIT $%d OFFSET $%d`,
+		columns, where, orderBy, direction, direction, len(args)-1, len(args))
+	rows, err := q(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employees: %w", err)
+	}
+	defer rows.Close()
+
+	var employees []*domain.Employee
+	for rows.Next() {
+		var e *domain.Employee
+		if fields != nil {
+			e, err = scanProjectedEmployee(rows, fields)
+		} else {
+			e, err = scanEmployee(rows)
+		}
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read employees: %w", err)
+	}
+
+	result := &domain.EmployeeResult{Employees: employees, TotalCount: total}
+	result.TotalPages, result.CurrentPage = pagination.PageNumbers(total)
+	if len(employees) > 0 {
+		result.PageInfo = domain.PageInfo{
+			HasNextPage:     offset+len(employees) < total,
+			HasPreviousPage: offset > 0,
+			StartCursor:     domain.EncodeCursor(offset),
+			EndCursor:       domain.EncodeCursor(offset + len(employees) - 1),
+		}
+	}
+	return result, nil
+}
+
+// StreamEmployees feeds every employee matching the filter to fn,
+// oldest first, iterating the result set server-side so exports of any
+// size run in bounded memory on a single query. Iteration stops at the
+// first callback error, which is returned unchanged.
+func (r *EmployeeRepository) StreamEmployees(ctx context.Context, filter domain.EmployeeFilter, fn func(*domain.Employee) error) error {
+	where, args := buildEmployeeWhere(ctx, filter)
+	query := fmt.Sprintf(`SELECT %s FROM employees%s ORDER BY created_at, id`, employeeColumns, where)
+	rows, err := q(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to stream employees: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read employees: %w", err)
+	}
+	return nil
+}
+
+// Count returns the number of employees matching the filter without
+// fetching any rows.
+func (r *EmployeeRepository) Count(ctx context.Context, filter domain.EmployeeFilter) (int, error) {
+	where, args := buildEmployeeWhere(ctx, filter)
+	var total int
+	err := q(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM employees`+where, args...).Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count employees: %w", err)
+	}
+	return total, nil
+}
+
+// Suggest returns employees whose first or last name starts with the
+// prefix, sorted by name. The prefix-anchored LIKE lets Postgres use the
+// pattern indexes on the name columns.
+func (r *EmployeeRepository) Suggest(ctx context.Context, prefix string, limit int) ([]*domain.Employee, error) {
+	args := []any{strings.ToLower(prefix) + "%", limit}
+	rows, err := q(ctx, r.db).QueryContext(ctx, `
+		SELECT `+employeeColumns+` FROM employees
+		WHERE (LOWER(first_name) LIKE $1 OR LOWER(last_name) LIKE $1) AND `+tenantCondition(ctx, &args)+`
+		ORDER BY last_name, first_name, id
+		LIMIT $2`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest employees: %w", err)
+	}
+	defer rows.Close()
+
+	var employees []*domain.Employee
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read employees: %w", err)
+	}
+	return employees, nil
+}
+
+// ExistsInDepartment reports whether any employee row belongs to the given
+// department.
+func (r *EmployeeRepository) ExistsInDepartment(ctx context.Context, department string) (bool, error) {
+	var exists bool
+	args := []any{department}
+	err := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM employees WHERE department = $1 AND `+tenantCondition(ctx, &args)+`)`,
+		args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check department existence: %w", err)
+	}
+	return exists, nil
+}
+
+// ExistsByEmail reports whether any employee row uses the given email.
+func (r *EmployeeRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	var exists bool
+	args := []any{email}
+	err := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM employees WHERE LOWER(email) = LOWER($1) AND `+tenantCondition(ctx, &args)+`)`,
+		args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check email existence: %w", err)
+	}
+	return exists, nil
+}
+
+// FindByEmail fetches an employee by email, case-insensitively, returning
+// (nil, nil) when no row matches.
+func (r *EmployeeRepository) FindByEmail(ctx context.Context, email string) (*domain.Employee, error) {
+	args := []any{email}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+employeeColumns+` FROM employees WHERE LOWER(email) = LOWER($1) AND `+tenantCondition(ctx, &args),
+		args...)
+	return scanEmployee(row)
+}
+
+// FindByUserID fetches the employee linked to the given user account,
+// returning (nil, nil) when no employee holds the link.
+func (r *EmployeeRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*domain.Employee, error) {
+	args := []any{userID}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+employeeColumns+` FROM employees WHERE user_id = $1 AND `+tenantCondition(ctx, &args), args...)
+	return scanEmployee(row)
+}
+
+// ListByManager returns all employees reporting directly to the manager.
+func (r *EmployeeRepository) ListByManager(ctx context.Context, managerID uuid.UUID) ([]*domain.Employee, error) {
+	args := []any{managerID}
+	rows, err := q(ctx, r.db).QueryContext(ctx,
+		`SELECT `+employeeColumns+` FROM employees WHERE manager_id = $1 AND `+tenantCondition(ctx, &args)+` ORDER BY last_name, first_name`,
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list employees by manager: %w", err)
+	}
+	defer rows.Close()
+
+	var employees []*domain.Employee
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, e)
+	}
+	return employees, rows.Err()
+}
+
+// ReassignManager moves every direct report of fromManagerID under
+// toManagerID in a single atomic statement.
+func (r *EmployeeRepository) ReassignManager(ctx context.Context, fromManagerID, toManagerID uuid.UUID) error {
+	args := []any{toManagerID, fromManagerID}
+	_, err := q(ctx, r.db).ExecContext(ctx, `
+		UPDATE employees
+		SET manager_id = $1, version = version + 1, updated_at = NOW()
+		WHERE manager_id = $2 AND `+tenantCondition(ctx, &args), args...)
+	if err != nil {
+		return fmt.Errorf("failed to reassign manager: %w", err)
+	}
+	return nil
+}
+
+// buildEmployeeWhere translates the filter into a WHERE clause and args,
+// always including the tenant scope.
+func buildEmployeeWhere(ctx context.Context, filter domain.EmployeeFilter) (string, []any) {
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Department != "" {
+		conditions = append(conditions, "department = "+arg(filter.Department))
+	}
+	if filter.Position != "" {
+		conditions = append(conditions, "position = "+arg(filter.Position))
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = "+arg(string(filter.Status)))
+	}
+	if filter.ManagerID != nil {
+		conditions = append(conditions, "manager_id = "+arg(*filter.ManagerID))
+	}
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		p := arg(pattern)
+		conditions = append(conditions, fmt.Sprintf("(first_name ILIKE %s OR last_name ILIKE %s OR email ILIKE %s)", p, p, p))
+	}
+	if filter.HiredAfter != nil {
+		conditions = append(conditions, "hire_date > "+arg(*filter.HiredAfter))
+	}
+	conditions = append(conditions, tenantCondition(ctx, &args))
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// projectableEmployeeColumns maps employee field names, as the API exposes
+// them, to their columns in select-list order. id, tenant_id, and version
+// are always selected and are not listed here.
+var projectableEmployeeColumns = []struct{ field, column string }{
+	{"firstName", "first_name"},
+	{"lastName", "last_name"},
+	{"email", "email"},
+	{"phone", "phone"},
+	{"department", "department"},
+	{"departmentId", "department_id"},
+	{"position", "position"},
+	{"salary", "salary"},
+	{"salaryCurrency", "salary_currency"},
+	{"hireDate", "hire_date"},
+	{"status", "status"},
+	{"employmentType", "employment_type"},
+	{"managerId", "manager_id"},
+	{"userId", "user_id"},
+	{"address", "address"},
+	{"createdAt", "created_at"},
+	{"updatedAt", "updated_at"},
+}
+
+// projectedEmployeeColumns translates requested field names into a select
+// list, returning the recognized fields in column order. Unknown names
+// and duplicates are dropped.
+func projectedEmployeeColumns(fields []string) (string, []string) {
+	requested := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		requested[field] = true
+	}
+	columns := []string{"id", "tenant_id", "version"}
+	selected := make([]string, 0, len(fields))
+	for _, pc := range projectableEmployeeColumns {
+		if requested[pc.field] {
+			columns = append(columns, pc.column)
+			selected = append(selected, pc.field)
+		}
+	}
+	return strings.Join(columns, ", "), selected
+}
+
+// employeeFieldDest returns the scan destination on e for the named field.
+// The address jsonb scans into raw and is decoded by the caller.
+func employeeFieldDest(e *domain.Employee, raw *[]byte, field string) any {
+	switch field {
+	case "firstName":
+		return &e.FirstName
+	case "lastName":
+		return &e.LastName
+	case "email":
+		return &e.Email
+	case "phone":
+		return &e.Phone
+	case "department":
+		return &e.Department
+	case "departmentId":
+		return &e.DepartmentID
+	case "position":
+		return &e.Position
+	case "salary":
+		return &e.Salary
+	case "salaryCurrency":
+		return &e.SalaryCurrency
+	case "hireDate":
+		return &e.HireDate
+	case "status":
+		return &e.Status
+	case "employmentType":
+		return &e.EmploymentType
+	case "managerId":
+		return &e.ManagerID
+	case "userId":
+		return &e.UserID
+	case "address":
+		return raw
+	case "createdAt":
+		return &e.CreatedAt
+	case "updatedAt":
+		return &e.UpdatedAt
+	}
+	return nil
+}
+
+// scanProjectedEmployee reads a row selected by projectedEmployeeColumns,
+// populating only the projected fields.
+func scanProjectedEmployee(row rowScanner, fields []string) (*domain.Employee, error) {
+	var e domain.Employee
+	var address []byte
+	dests := []any{&e.ID, &e.TenantID, &e.Version}
+	for _, field := range fields {
+		dests = append(dests, employeeFieldDest(&e, &address, field))
+	}
+	if err := row.Scan(dests...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to scan employee: %w", err)
+	}
+	if len(address) > 0 {
+		e.Address = &domain.Address{}
+		if err := json.Unmarshal(address, e.Address); err != nil {
+			return nil, fmt.Errorf("failed to decode address: %w", err)
+		}
+	}
+	return &e, nil
+}
+
+// rowScanner abstracts *sql.Row and *sql.Rows for the scan helpers.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEmployee reads one employee row, returning (nil, nil) on sql.ErrNoRows.
+func scanEmployee(row rowScanner) (*domain.Employee, error) {
+	var e domain.Employee
+	var address []byte
+	err := row.Scan(&e.ID, &e.FirstName, &e.LastName, &e.Email, &e.Phone,
+		&e.Department, &e.DepartmentID, &e.Position, &e.Salary, &e.SalaryCurrency, &e.HireDate,
+		&e.Status, &e.EmploymentType, &e.ManagerID, &e.UserID, &address, &e.TenantID, &e.Version, &e.CreatedAt, &e.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan employee: %w", err)
+	}
+	if len(address) > 0 {
+		e.Address = &domain.Address{}
+		if err := json.Unmarshal(address, e.Address); err != nil {
+			return nil, fmt.Errorf("failed to decode address: %w", err)
+		}
+	}
+	return &e, nil
+}
+
+// marshalAddress serializes the address for the jsonb column, keeping NULL
+// for absent addresses.
+func marshalAddress(a *domain.Address) ([]byte, error) {
+	if a == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode address: %w", err)
+	}
+	return data, nil
+}
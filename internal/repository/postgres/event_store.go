@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// EventStore is the Postgres implementation of domain.EventStoreRepository,
+// appending events to an append-only table.
+type EventStore struct {
+	db *sql.DB
+}
+
+// NewEventStore creates an EventStore on the given handle.
+func NewEventStore(db *sql.DB) *EventStore {
+	return &EventStore{db: db}
+}
+
+// SaveEvent appends a serialized domain event. The sequence column is
+// assigned by the database, giving every event a monotonically increasing
+// position in the global stream.
+func (s *EventStore) SaveEvent(ctx context.Context, event domain.DomainEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	_, err = q(ctx, s.db).ExecContext(ctx, `
+		INSERT INTO events (id, aggregate_id, event_type, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		uuid.New(), event.AggregateID(), event.EventType(), payload, event.OccurredAt())
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %w", err)
+	}
+	return nil
+}
+
+// LoadEvents returns all stored events for the aggregate in sequence
+// order, upcasting older payloads to the current schema for replay.
+func (s *EventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]*domain.StoredEvent, error) {
+	rows, err := q(ctx, s.db).QueryContext(ctx, `
+		SELECT id, aggregate_id, event_type, payload, sequence, occurred_at
+		FROM events WHERE aggregate_id = $1 ORDER BY sequence`, aggregateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.StoredEvent
+	for rows.Next() {
+		var e domain.StoredEvent
+		if err := rows.Scan(&e.ID, &e.AggregateID, &e.EventType, &e.Payload, &e.Sequence, &e.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		if err := domain.UpcastStoredEvent(&e); err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// positionColumns is the select list shared by all position queries.
+const positionColumns = `id, title, level, department_id, created_at, updated_at`
+
+// PositionRepository is the Postgres implementation of
+// domain.PositionRepository.
+type PositionRepository struct {
+	db *sql.DB
+}
+
+// NewPositionRepository creates a PositionRepository on the given handle.
+func NewPositionRepository(db *sql.DB) *PositionRepository {
+	return &PositionRepository{db: db}
+}
+
+// Create inserts a new position row.
+func (r *PositionRepository) Create(ctx context.Context, p *domain.Position) error {
+	_, err := q(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO positions (id, title, level, department_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		p.ID, p.Title, p.Level, p.DepartmentID, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert position: %w", err)
+	}
+	return nil
+}
+
+// GetByID fetches a position by primary key, returning
+// domain.ErrPositionNotFound when no row matches.
+func (r *PositionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Position, error) {
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+positionColumns+` FROM positions WHERE id = $1`, id)
+	position, err := scanPosition(row)
+	if err != nil {
+		return nil, err
+	}
+	if position == nil {
+		return nil, domain.ErrPositionNotFound
+	}
+	return position, nil
+}
+
+// FindByTitle fetches a position by title, case-insensitively, returning
+// (nil, nil) when no row matches.
+func (r *PositionRepository) FindByTitle(ctx context.Context, title string) (*domain.Position, error) {
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+positionColumns+` FROM positions WHERE LOWER(title) = LOWER($1)`, title)
+	return scanPosition(row)
+}
+
+// List returns all positions ordered by level then title.
+func (r *PositionRepository) List(ctx context.Context) ([]*domain.Position, error) {
+	rows, err := q(ctx, r.db).QueryContext(ctx,
+		`SELECT `+positionColumns+` FROM positions ORDER BY level, title, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []*domain.Position
+	for rows.Next() {
+		p, err := scanPosition(rows)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read positions: %w", err)
+	}
+	return positions, nil
+}
+
+// scanPosition reads one position row, returning (nil, nil) on
+// sql.ErrNoRows.
+func scanPosition(row rowScanner) (*domain.Position, error) {
+	var p domain.Position
+	err := row.Scan(&p.ID, &p.Title, &p.Level, &p.DepartmentID, &p.CreatedAt, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan position: %w", err)
+	}
+	return &p, nil
+}
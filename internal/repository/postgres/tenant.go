@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// tenantCondition returns a SQL condition restricting rows to the tenant
+// carried in ctx, appending the tenant value to args. When the context has
+// the system-level cross-tenant flag the condition is TRUE, disabling the
+// restriction.
+func tenantCondition(ctx context.Context, args *[]any) string {
+	if domain.CrossTenantAccessAllowed(ctx) {
+		return "TRUE"
+	}
+	*args = append(*args, domain.TenantFrom(ctx))
+	return fmt.Sprintf("tenant_id = $%d", len(*args))
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// txContextKey is the private context key carrying an open transaction.
+type txContextKey struct{}
+
+// querier is the subset of *sql.DB and *sql.Tx the repositories use, so
+// every method runs against the ambient transaction when one is open.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// q returns the transaction carried in ctx by Transactor.Transact, or the
+// plain handle when none is open.
+func q(ctx context.Context, db *sql.DB) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// Transactor implements domain.Transactor over a database handle. The
+// transaction travels in the context, so repository calls made with the
+// function's context join it transparently.
+type Transactor struct {
+	db *sql.DB
+}
+
+// NewTransactor creates a Transactor on the given handle.
+func NewTransactor(db *sql.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// Transact runs fn inside a transaction, committing when it returns nil
+// and rolling back otherwise.
+func (t *Transactor) Transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("failed to roll back transaction: %v (after: %w)", rbErr, err)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Compile-time check that Transactor satisfies the domain port.
+var _ domain.Transactor = (*Transactor)(nil)
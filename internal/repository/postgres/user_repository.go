@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// userColumns is the select list shared by all user queries.
+const userColumns = `id, username, email, password_hash, role, is_active, last_login, tenant_id, version, token_version, created_at, updated_at`
+
+// UserRepository is the Postgres implementation of domain.UserRepository.
+// Every query is scoped to the tenant carried in the context; see
+// tenantCondition.
+type UserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates a UserRepository on the given handle.
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Create inserts a new user row.
+func (r *UserRepository) Create(ctx context.Context, u *domain.User) error {
+	_, err := q(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO users (id, username, email, password_hash, role, is_active, last_login, tenant_id, version, token_version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		u.ID, u.Username, u.Email, u.PasswordHash, u.Role, u.IsActive, u.LastLogin, u.TenantID, u.Version, u.TokenVersion, u.CreatedAt, u.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert user: %w", err)
+	}
+	return nil
+}
+
+// GetByID fetches a user by primary key, returning domain.ErrUserNotFound
+// when no row matches.
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	args := []any{id}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE id = $1 AND `+tenantCondition(ctx, &args), args...)
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// FindByUsername fetches a user by username, case-insensitively, returning
+// (nil, nil) when no row matches.
+func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*domain.User, error) {
+	args := []any{username}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE LOWER(username) = LOWER($1) AND `+tenantCondition(ctx, &args), args...)
+	return scanUser(row)
+}
+
+// FindByEmail fetches a user by email, case-insensitively, returning
+// (nil, nil) when no row matches.
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	args := []any{email}
+	row := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+userColumns+` FROM users WHERE LOWER(email) = LOWER($1) AND `+tenantCondition(ctx, &args), args...)
+	return scanUser(row)
+}
+
+// Update persists user changes, enforcing optimistic locking on the
+// version column.
+func (r *UserRepository) Update(ctx context.Context, u *domain.User) error {
+	args := []any{u.Username, u.Email, u.PasswordHash, u.Role, u.IsActive, u.LastLogin, u.TokenVersion, u.UpdatedAt, u.ID, u.Version}
+	result, err := q(ctx, r.db).ExecContext(ctx, `
+		UPDATE users
+		SET username = $1, email = $2, password_hash = $3, role = $4, is_active = $5,
+			last_login = $6, token_version = $7, version = version + 1, updated_at = $8
+		WHERE id = $9 AND version = $10 AND `+tenantCondition(ctx, &args), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check update result: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrConcurrentModification
+	}
+	u.Version++
+	return nil
+}
+
+// List returns a filtered page of users with a total count.
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter, pagination domain.Pagination) (*domain.UserResult, error) {
+	where, args := buildUserWhere(ctx, filter)
+
+	var total int
+	if err := q(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	offset := pagination.Offset
+	if pagination.After != "" {
+		decoded, err := domain.DecodeCursor(pagination.After)
+		if err != nil {
+			return nil, err
+		}
+		offset = decoded + 1
+	}
+
+	args = append(args, pagination.Limit, offset)
+	query := fmt.Sprintf(`SELECT %s FROM users%s ORDER BY username, id LIMIT $%d OFFSET $%d`,
+		userColumns, where, len(args)-1, len(args))
+	rows, err := q(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read users: %w", err)
+	}
+
+	result := &domain.UserResult{Users: users, TotalCount: total}
+	result.TotalPages, result.CurrentPage = pagination.PageNumbers(total)
+	if len(users) > 0 {
+		result.PageInfo = domain.PageInfo{
+			HasNextPage:     offset+len(users) < total,
+			HasPreviousPage: offset > 0,
+			StartCursor:     domain.EncodeCursor(offset),
+			EndCursor:       domain.EncodeCursor(offset + len(users) - 1),
+		}
+	}
+	return result, nil
+}
+
+// ExistsByUsername reports whether any user row has the given username,
+// compared case-insensitively so "Admin" and "admin" cannot coexist.
+func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	var exists bool
+	args := []any{username}
+	err := q(ctx, r.db).QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM users WHERE LOWER(username) = LOWER($1) AND `+tenantCondition(ctx, &args)+`)`,
+		args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check username existence: %w", err)
+	}
+	return exists, nil
+}
+
+// buildUserWhere translates the filter into a WHERE clause and args,
+// always including the tenant scope.
+func buildUserWhere(ctx context.Context, filter domain.UserFilter) (string, []any) {
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		p := arg(pattern)
+		conditions = append(conditions, fmt.Sprintf("(username ILIKE %s OR email ILIKE %s)", p, p))
+	}
+	if filter.Role != "" {
+		conditions = append(conditions, "role = "+arg(string(filter.Role)))
+	}
+	if filter.IsActive != nil {
+		conditions = append(conditions, "is_active = "+arg(*filter.IsActive))
+	}
+	if filter.LastLoginSince != nil {
+		conditions = append(conditions, "last_login >= "+arg(*filter.LastLoginSince))
+	}
+	if filter.NeverLoggedIn {
+		conditions = append(conditions, "last_login IS NULL")
+	}
+	conditions = append(conditions, tenantCondition(ctx, &args))
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// scanUser reads one user row, returning (nil, nil) on sql.ErrNoRows.
+func scanUser(row rowScanner) (*domain.User, error) {
+	var u domain.User
+	err := row.Scan(&u.ID, &u.Username, &u.Email, &u.PasswordHash, &u.Role,
+		&u.IsActive, &u.LastLogin, &u.TenantID, &u.Version, &u.TokenVersion, &u.CreatedAt, &u.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan user: %w", err)
+	}
+	return &u, nil
+}
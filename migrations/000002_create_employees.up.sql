@@ -0,0 +1,22 @@
+CREATE TABLE employees (
+    id UUID PRIMARY KEY,
+    first_name VARCHAR(50) NOT NULL,
+    last_name VARCHAR(50) NOT NULL,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    phone VARCHAR(30) NOT NULL DEFAULT '',
+    department VARCHAR(100) NOT NULL,
+    position VARCHAR(100) NOT NULL,
+    salary NUMERIC(12, 2) NOT NULL,
+    hire_date TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    employment_type VARCHAR(20) NOT NULL DEFAULT 'FULL_TIME',
+    manager_id UUID REFERENCES employees (id),
+    address JSONB,
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_employees_department ON employees (department);
+CREATE INDEX idx_employees_manager_id ON employees (manager_id);
+CREATE INDEX idx_employees_status ON employees (status);
@@ -0,0 +1,25 @@
+CREATE TABLE audit_logs (
+    id UUID PRIMARY KEY,
+    user_id UUID,
+    employee_id UUID,
+    operation VARCHAR(50) NOT NULL,
+    old_values JSONB,
+    new_values JSONB,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    timestamp TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_user_id ON audit_logs (user_id);
+CREATE INDEX idx_audit_logs_employee_id ON audit_logs (employee_id);
+CREATE INDEX idx_audit_logs_timestamp ON audit_logs (timestamp DESC);
+
+CREATE TABLE events (
+    id UUID PRIMARY KEY,
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_events_aggregate_id ON events (aggregate_id);
@@ -0,0 +1,2 @@
+DROP INDEX idx_users_email_lower;
+DROP INDEX idx_employees_email_lower;
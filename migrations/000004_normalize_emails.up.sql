@@ -0,0 +1,5 @@
+UPDATE employees SET email = LOWER(email);
+UPDATE users SET email = LOWER(email);
+
+CREATE UNIQUE INDEX idx_employees_email_lower ON employees (LOWER(email));
+CREATE UNIQUE INDEX idx_users_email_lower ON users (LOWER(email));
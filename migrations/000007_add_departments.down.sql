@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_employees_department_id;
+ALTER TABLE employees DROP COLUMN department_id;
+DROP INDEX IF EXISTS idx_departments_name_lower;
+DROP TABLE IF EXISTS departments;
@@ -0,0 +1,12 @@
+CREATE TABLE departments (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    parent_id UUID REFERENCES departments(id),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_departments_name_lower ON departments (LOWER(name));
+
+ALTER TABLE employees ADD COLUMN department_id UUID REFERENCES departments(id);
+CREATE INDEX idx_employees_department_id ON employees (department_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_positions_title_lower;
+DROP TABLE IF EXISTS positions;
@@ -0,0 +1,10 @@
+CREATE TABLE positions (
+    id UUID PRIMARY KEY,
+    title VARCHAR(100) NOT NULL,
+    level INTEGER NOT NULL,
+    department_id UUID REFERENCES departments(id),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_positions_title_lower ON positions (LOWER(title));
@@ -0,0 +1 @@
+ALTER TABLE employees ADD COLUMN salary_currency VARCHAR(3) NOT NULL DEFAULT 'USD';
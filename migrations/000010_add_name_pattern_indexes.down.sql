@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_employees_last_name_pattern;
+DROP INDEX IF EXISTS idx_employees_first_name_pattern;
@@ -0,0 +1,2 @@
+CREATE INDEX idx_employees_first_name_pattern ON employees (LOWER(first_name) text_pattern_ops);
+CREATE INDEX idx_employees_last_name_pattern ON employees (LOWER(last_name) text_pattern_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_employees_user_id;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,3 @@
+ALTER TABLE employees ADD COLUMN user_id UUID REFERENCES users(id);
+
+CREATE UNIQUE INDEX idx_employees_user_id ON employees(user_id) WHERE user_id IS NOT NULL;
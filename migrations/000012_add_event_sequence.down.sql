@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_events_sequence;
+
+ALTER TABLE events DROP COLUMN IF EXISTS sequence;
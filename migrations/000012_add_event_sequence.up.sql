@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN sequence BIGSERIAL;
+
+CREATE UNIQUE INDEX idx_events_sequence ON events(sequence);
@@ -0,0 +1 @@
+ALTER TABLE audit_logs ADD COLUMN request_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_audit_logs_tenant;
+DROP INDEX IF EXISTS idx_users_tenant;
+DROP INDEX IF EXISTS idx_employees_tenant;
+
+ALTER TABLE audit_logs DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE employees DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,7 @@
+ALTER TABLE employees ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE users ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE audit_logs ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+CREATE INDEX idx_employees_tenant ON employees (tenant_id);
+CREATE INDEX idx_users_tenant ON users (tenant_id);
+CREATE INDEX idx_audit_logs_tenant ON audit_logs (tenant_id);
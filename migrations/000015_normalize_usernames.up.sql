@@ -0,0 +1,15 @@
+-- Uniqueness on usernames is case-insensitive from this migration on;
+-- the column keeps its display case. Later-created case variants of the
+-- same username are renamed with a numeric suffix first so the unique
+-- index can be created on live data.
+WITH dupes AS (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY LOWER(username) ORDER BY created_at, id) AS rn
+    FROM users
+)
+UPDATE users
+SET username = LEFT(users.username, 28) || dupes.rn
+FROM dupes
+WHERE users.id = dupes.id AND dupes.rn > 1;
+
+CREATE UNIQUE INDEX idx_users_username_lower ON users (LOWER(username));
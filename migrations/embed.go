@@ -0,0 +1,11 @@
+// Package migrations embeds the SQL schema migrations so single-binary
+// deployments can run them without shipping the directory alongside the
+// executable.
+package migrations
+
+import "embed"
+
+// FS holds every SQL migration in this directory.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,71 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestBatchedQueriesReturnResultsInOrder verifies a JSON-array POST body
+// executes every operation and returns results in request order.
+func TestBatchedQueriesReturnResultsInOrder(t *testing.T) {
+	admin, err := domain.NewUser("batch_admin", "batch_admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("failed to build admin: %v", err)
+	}
+	employee, err := domain.NewEmployee("Ada", "Lovelace", "ada.lovelace@example.com",
+		"", "Engineering", "Analyst", 120000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("failed to build employee: %v", err)
+	}
+
+	server := helpers.NewTestServer(t,
+		helpers.WithSeedUser(admin),
+		helpers.WithSeedEmployee(employee),
+	)
+	defer server.Close()
+
+	client := server.Client().WithAuth(server.TokenFor(t, admin))
+	results, err := client.ExecuteBatch([]helpers.BatchOperation{
+		{Query: `query { employees(first: 5) { totalCount } }`},
+		{Query: `query { me { username } }`},
+	})
+	if err != nil {
+		t.Fatalf("batch request failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for i, resp := range results {
+		if resp.HasErrors() {
+			t.Fatalf("result %d has errors: %v", i, resp.ErrorMessages())
+		}
+	}
+	results[0].AssertDataPath(t, "employees.totalCount", 1)
+	results[1].AssertDataPath(t, "me.username", "batch_admin")
+}
+
+// TestBatchedQueriesShareRequestAuth verifies the batch runs under the HTTP
+// request's auth context: without a token every operation fails.
+func TestBatchedQueriesShareRequestAuth(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	results, err := server.Client().ExecuteBatch([]helpers.BatchOperation{
+		{Query: `query { employees(first: 5) { totalCount } }`},
+		{Query: `query { me { username } }`},
+	})
+	if err != nil {
+		t.Fatalf("batch request failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for i, resp := range results {
+		if !resp.HasErrors() {
+			t.Fatalf("result %d: expected an authentication error, got none", i)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package contract
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestAuthenticateSetsAuthCookie verifies login issues the token as an
+// HttpOnly, Secure, SameSite=Strict cookie for browser clients.
+func TestAuthenticateSetsAuthCookie(t *testing.T) {
+	user, err := domain.NewUser("cookie_user", "cookie_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	server := helpers.NewTestServer(t, helpers.WithSeedUser(user))
+	defer server.Close()
+
+	body := `{"query":"mutation { authenticate(method: \"username\", credentials: {username: \"cookie_user\", password: \"Str0ng-Passw0rd!\"}) { token } }"}`
+	resp, err := http.Post(server.BaseURL, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var cookie *http.Cookie
+	for _, c := range resp.Cookies() {
+		if c.Name == "auth-token" {
+			cookie = c
+		}
+	}
+	if cookie == nil {
+		t.Fatalf("no auth-token cookie in response, got %v", resp.Header.Values("Set-Cookie"))
+	}
+	if cookie.Value == "" {
+		t.Error("auth-token cookie is empty")
+	}
+	if !cookie.HttpOnly {
+		t.Error("auth-token cookie is not HttpOnly")
+	}
+	if !cookie.Secure {
+		t.Error("auth-token cookie is not Secure")
+	}
+	if cookie.SameSite != http.SameSiteStrictMode {
+		t.Errorf("auth-token cookie SameSite = %v, want Strict", cookie.SameSite)
+	}
+}
+
+// TestAuthCookieAuthenticatesRequests verifies the middleware accepts the
+// cookie in place of the Authorization header.
+func TestAuthCookieAuthenticatesRequests(t *testing.T) {
+	user, err := domain.NewUser("cookie_viewer", "cookie_viewer@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	server := helpers.NewTestServer(t, helpers.WithSeedUser(user))
+	defer server.Close()
+
+	client := server.Client().WithHeader("Cookie", "auth-token="+server.TokenFor(t, user))
+	resp, err := client.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors: %v", resp.ErrorMessages())
+	}
+	resp.AssertDataPath(t, "me.username", "cookie_viewer")
+}
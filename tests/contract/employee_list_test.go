@@ -0,0 +1,94 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestEmployeesQueryRequiresAuth verifies the employees connection rejects
+// unauthenticated callers.
+func TestEmployeesQueryRequiresAuth(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	resp, err := server.Client().Execute(`
+		query {
+			employees(first: 5) {
+				totalCount
+			}
+		}`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected an authentication error, got none")
+	}
+}
+
+// TestAuthenticateRejectsBadCredentials verifies the authenticate mutation
+// fails closed for unknown users.
+func TestAuthenticateRejectsBadCredentials(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	resp, err := server.Client().Execute(`
+		mutation($credentials: Json!) {
+			authenticate(method: "username", credentials: $credentials) {
+				token
+			}
+		}`, map[string]any{
+		"credentials": map[string]any{
+			"username": "no_such_user",
+			"password": "Wrong-Password-1!",
+		},
+	})
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected an authentication error, got none")
+	}
+}
+
+// TestEmployeesQueryReturnsSeededData verifies an authenticated caller can
+// page through seeded employees.
+func TestEmployeesQueryReturnsSeededData(t *testing.T) {
+	admin, err := domain.NewUser("contract_admin", "contract_admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("failed to build admin: %v", err)
+	}
+	employee, err := domain.NewEmployee("Grace", "Hopper", "grace.hopper@example.com",
+		"", "Engineering", "Rear Admiral", 150000, time.Now().AddDate(-2, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("failed to build employee: %v", err)
+	}
+
+	server := helpers.NewTestServer(t,
+		helpers.WithSeedUser(admin),
+		helpers.WithSeedEmployee(employee),
+	)
+	defer server.Close()
+
+	client := server.Client().WithAuth(server.TokenFor(t, admin))
+	resp, err := client.Execute(`
+		query {
+			employees(first: 5) {
+				totalCount
+				edges {
+					node { email }
+				}
+			}
+		}`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors: %v", resp.ErrorMessages())
+	}
+
+	resp.AssertDataPath(t, "employees.totalCount", 1)
+	resp.AssertDataPath(t, "employees.edges[0].node.email", "grace.hopper@example.com")
+}
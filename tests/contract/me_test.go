@@ -0,0 +1,44 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestMeQueryReturnsCurrentUser verifies the me query resolves the
+// authenticated user from context.
+func TestMeQueryReturnsCurrentUser(t *testing.T) {
+	viewer, err := domain.NewUser("contract_viewer", "contract_viewer@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	server := helpers.NewTestServer(t, helpers.WithSeedUser(viewer))
+	defer server.Close()
+
+	client := server.Client().WithAuth(server.TokenFor(t, viewer))
+	resp, err := client.Execute(`query { me { username role } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors: %v", resp.ErrorMessages())
+	}
+	resp.AssertDataPath(t, "me.username", "contract_viewer")
+	resp.AssertDataPath(t, "me.role", "VIEWER")
+}
+
+// TestMeQueryRequiresAuth verifies the me query rejects anonymous callers.
+func TestMeQueryRequiresAuth(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	resp, err := server.Client().Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected an authentication error, got none")
+	}
+}
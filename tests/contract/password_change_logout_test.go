@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestPasswordChangeInvalidatesOutstandingTokens verifies that a token
+// issued before a password change stops authenticating afterwards, while
+// logging in with the new password works.
+func TestPasswordChangeInvalidatesOutstandingTokens(t *testing.T) {
+	user, err := domain.NewUser("logout_user", "logout_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	server := helpers.NewTestServer(t, helpers.WithSeedUser(user))
+	defer server.Close()
+
+	oldSession := server.Client().WithAuth(server.TokenFor(t, user))
+	resp, err := oldSession.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors before the password change: %v", resp.ErrorMessages())
+	}
+
+	resp, err = oldSession.Execute(`
+		mutation {
+			changePassword(currentPassword: "Str0ng-Passw0rd!", newPassword: "N3w-Str0ng-Passw0rd!")
+		}`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("changePassword failed: %v", resp.ErrorMessages())
+	}
+
+	resp, err = oldSession.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected the pre-change token to be rejected, got none")
+	}
+
+	resp, err = server.Client().Execute(`
+		mutation($credentials: Json!) {
+			authenticate(method: "username", credentials: $credentials) {
+				token
+			}
+		}`, map[string]any{
+		"credentials": map[string]any{
+			"username": "logout_user",
+			"password": "N3w-Str0ng-Passw0rd!",
+		},
+	})
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("login with the new password failed: %v", resp.ErrorMessages())
+	}
+	var token string
+	resp.DecodeInto(t, "authenticate.token", &token)
+	if token == "" {
+		t.Fatal("expected a token from the new login")
+	}
+
+	newSession := server.Client().WithAuth(token)
+	resp, err = newSession.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors with the post-change token: %v", resp.ErrorMessages())
+	}
+	resp.AssertDataPath(t, "me.username", "logout_user")
+}
@@ -0,0 +1,88 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestEmployeesProjectionReturnsRequestedFields verifies a projected
+// connection query still resolves the selected fields, including derived
+// ones such as fullName.
+func TestEmployeesProjectionReturnsRequestedFields(t *testing.T) {
+	admin, err := domain.NewUser("proj_admin", "proj_admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("failed to build admin: %v", err)
+	}
+	employee, err := domain.NewEmployee("Grace", "Hopper", "grace.hopper@example.com",
+		"", "Engineering", "Rear Admiral", 150000, time.Now().AddDate(-2, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("failed to build employee: %v", err)
+	}
+
+	server := helpers.NewTestServer(t,
+		helpers.WithSeedUser(admin),
+		helpers.WithSeedEmployee(employee),
+	)
+	defer server.Close()
+
+	client := server.Client().WithAuth(server.TokenFor(t, admin))
+	resp, err := client.Execute(`
+		query {
+			employees(first: 5) {
+				edges {
+					node { fullName salary }
+				}
+			}
+		}`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors: %v", resp.ErrorMessages())
+	}
+
+	resp.AssertDataPath(t, "employees.edges[0].node.fullName", "Grace Hopper")
+	resp.AssertDataPath(t, "employees.edges[0].node.salary", float64(150000))
+}
+
+// TestEmployeesProjectionHidesSalaryFromViewers verifies salary is not
+// fetched for roles without salary permission.
+func TestEmployeesProjectionHidesSalaryFromViewers(t *testing.T) {
+	viewer, err := domain.NewUser("proj_viewer", "proj_viewer@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("failed to build viewer: %v", err)
+	}
+	employee, err := domain.NewEmployee("Grace", "Hopper", "grace.hopper@example.com",
+		"", "Engineering", "Rear Admiral", 150000, time.Now().AddDate(-2, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("failed to build employee: %v", err)
+	}
+
+	server := helpers.NewTestServer(t,
+		helpers.WithSeedUser(viewer),
+		helpers.WithSeedEmployee(employee),
+	)
+	defer server.Close()
+
+	client := server.Client().WithAuth(server.TokenFor(t, viewer))
+	resp, err := client.Execute(`
+		query {
+			employees(first: 5) {
+				edges {
+					node { email salary }
+				}
+			}
+		}`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors: %v", resp.ErrorMessages())
+	}
+
+	resp.AssertDataPath(t, "employees.edges[0].node.email", "grace.hopper@example.com")
+	resp.AssertDataPath(t, "employees.edges[0].node.salary", float64(0))
+}
@@ -0,0 +1,65 @@
+package contract
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestSecurityHeaders verifies every response carries the standard
+// security headers.
+func TestSecurityHeaders(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	resp, err := http.Post(server.BaseURL, "application/json", strings.NewReader(`{"query":"query { __typename }"}`))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	for header, want := range map[string]string{
+		"X-Content-Type-Options": "nosniff",
+		"X-Frame-Options":        "DENY",
+		"X-XSS-Protection":       "1; mode=block",
+	} {
+		if got := resp.Header.Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+}
+
+// TestCORSPreflight verifies the GraphQL endpoint answers preflight
+// requests with the expected CORS headers.
+func TestCORSPreflight(t *testing.T) {
+	server := helpers.NewTestServer(t)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodOptions, server.BaseURL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	for header, want := range map[string]string{
+		"Access-Control-Allow-Origin":  "*",
+		"Access-Control-Allow-Methods": "POST, OPTIONS",
+		"Access-Control-Allow-Headers": "Content-Type, Authorization",
+	} {
+		if got := resp.Header.Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/graphql"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestQueryTimeoutReturnsTimeoutError verifies the server-side deadline
+// cuts an operation off with a classified TIMEOUT error.
+func TestQueryTimeoutReturnsTimeoutError(t *testing.T) {
+	server := helpers.NewTestServer(t, helpers.WithHandlerOptions(
+		graphql.WithOperationTimeouts(time.Nanosecond, time.Second)))
+	defer server.Close()
+
+	resp, err := server.Client().Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected a timeout error, got none")
+	}
+	if code := resp.Errors[0].Extensions["code"]; code != "TIMEOUT" {
+		t.Fatalf("error code = %v, want TIMEOUT", code)
+	}
+	if resp.Errors[0].Message != "operation timed out" {
+		t.Fatalf("error message = %q, want %q", resp.Errors[0].Message, "operation timed out")
+	}
+}
+
+// TestMutationTimeoutIsSeparatelyConfigured verifies a generous mutation
+// deadline is unaffected by an exhausted query deadline.
+func TestMutationTimeoutIsSeparatelyConfigured(t *testing.T) {
+	server := helpers.NewTestServer(t, helpers.WithHandlerOptions(
+		graphql.WithOperationTimeouts(time.Nanosecond, time.Second)))
+	defer server.Close()
+
+	resp, err := server.Client().Execute(`
+		mutation($credentials: Json!) {
+			authenticate(method: "username", credentials: $credentials) {
+				token
+			}
+		}`, map[string]any{
+		"credentials": map[string]any{
+			"username": "no_such_user",
+			"password": "Wrong-Password-1!",
+		},
+	})
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected an authentication error, got none")
+	}
+	if code := resp.Errors[0].Extensions["code"]; code == "TIMEOUT" {
+		t.Fatal("mutation hit the query timeout; deadlines are not separate")
+	}
+}
@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// TestExpiredTokenIsRejected verifies an expired token does not
+// authenticate requests while a fresh one does.
+func TestExpiredTokenIsRejected(t *testing.T) {
+	user, err := domain.NewUser("expiry_user", "expiry_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	server := helpers.NewTestServer(t, helpers.WithSeedUser(user))
+	defer server.Close()
+
+	expired := server.Client().WithAuth(server.ExpiredTokenFor(t, user))
+	resp, err := expired.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if !resp.HasErrors() {
+		t.Fatal("expected an expired token to be rejected, got none")
+	}
+
+	fresh := server.Client().WithAuth(server.TokenFor(t, user))
+	resp, err = fresh.Execute(`query { me { username } }`, nil)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if resp.HasErrors() {
+		t.Fatalf("unexpected errors with a fresh token: %v", resp.ErrorMessages())
+	}
+	resp.AssertDataPath(t, "me.username", "expiry_user")
+}
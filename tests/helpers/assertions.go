@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// AssertDataPath fails the test unless the value at the dotted path (e.g.
+// "employees.edges[0].node.email") equals expected. Numeric values are
+// compared as float64, matching JSON decoding.
+func (r *GraphQLResponse) AssertDataPath(t *testing.T, path string, expected any) {
+	t.Helper()
+	value, err := r.dataPath(path)
+	if err != nil {
+		t.Fatalf("path %q: %v (data: %v)", path, err, r.Data)
+	}
+	if !reflect.DeepEqual(value, normalizeNumber(expected)) {
+		t.Fatalf("path %q: expected %v (%T), got %v (%T)", path, expected, expected, value, value)
+	}
+}
+
+// DecodeInto unmarshals the value at the dotted path into target, which
+// must be a pointer.
+func (r *GraphQLResponse) DecodeInto(t *testing.T, path string, target any) {
+	t.Helper()
+	value, err := r.dataPath(path)
+	if err != nil {
+		t.Fatalf("path %q: %v (data: %v)", path, err, r.Data)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("path %q: failed to re-encode value: %v", path, err)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		t.Fatalf("path %q: failed to decode into %T: %v", path, target, err)
+	}
+}
+
+// dataPath walks the response data along the path, resolving map keys and
+// [n] list indexes.
+func (r *GraphQLResponse) dataPath(path string) (any, error) {
+	var current any = r.Data
+	for _, segment := range strings.Split(path, ".") {
+		key, indexes, err := splitSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			m, ok := current.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("segment %q: expected object, got %T", segment, current)
+			}
+			current, ok = m[key]
+			if !ok {
+				return nil, fmt.Errorf("segment %q: key not present", segment)
+			}
+		}
+		for _, index := range indexes {
+			list, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("segment %q: expected list, got %T", segment, current)
+			}
+			if index < 0 || index >= len(list) {
+				return nil, fmt.Errorf("segment %q: index %d out of range (len %d)", segment, index, len(list))
+			}
+			current = list[index]
+		}
+	}
+	return current, nil
+}
+
+// splitSegment parses one path segment like "edges[0][1]" into its key and
+// index list.
+func splitSegment(segment string) (string, []int, error) {
+	key := segment
+	var indexes []int
+	for {
+		open := strings.Index(key, "[")
+		if open < 0 {
+			break
+		}
+		closing := strings.Index(key[open:], "]")
+		if closing < 0 {
+			return "", nil, fmt.Errorf("segment %q: unterminated index", segment)
+		}
+		index, err := strconv.Atoi(key[open+1 : open+closing])
+		if err != nil {
+			return "", nil, fmt.Errorf("segment %q: invalid index", segment)
+		}
+		indexes = append(indexes, index)
+		key = key[:open] + key[open+closing+1:]
+	}
+	return key, indexes, nil
+}
+
+// normalizeNumber converts integer expectations to float64 so they compare
+// equal to JSON-decoded numbers.
+func normalizeNumber(expected any) any {
+	switch v := expected.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	}
+	return expected
+}
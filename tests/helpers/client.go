@@ -0,0 +1,204 @@
+// Package helpers provides shared utilities for the contract and
+// integration test suites.
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GraphQLClient is a minimal GraphQL-over-HTTP client for tests. It can
+// retry connection-level failures with backoff so flaky CI networking does
+// not fail tests spuriously; GraphQL-level errors are never retried.
+type GraphQLClient struct {
+	baseURL    string
+	httpClient *http.Client
+	authToken  string
+	headers    map[string]string
+	maxRetries int
+	backoff    time.Duration
+}
+
+// ClientOption configures a GraphQLClient at construction time.
+type ClientOption func(*GraphQLClient)
+
+// WithRetries makes the client retry connection errors up to maxRetries
+// times, waiting backoff (doubling each attempt) between tries.
+func WithRetries(maxRetries int, backoff time.Duration) ClientOption {
+	return func(c *GraphQLClient) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// WithTimeout sets the per-request timeout.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *GraphQLClient) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// NewGraphQLClient creates a client targeting the given server base URL.
+func NewGraphQLClient(baseURL string, opts ...ClientOption) *GraphQLClient {
+	client := &GraphQLClient{
+		baseURL:    baseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		headers:    make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
+// WithAuth returns a copy of the client that sends the given bearer token.
+func (c *GraphQLClient) WithAuth(token string) *GraphQLClient {
+	clone := c.clone()
+	clone.authToken = token
+	return clone
+}
+
+// WithHeader returns a copy of the client that sends the given header on
+// every request.
+func (c *GraphQLClient) WithHeader(key, value string) *GraphQLClient {
+	clone := c.clone()
+	clone.headers[key] = value
+	return clone
+}
+
+// clone copies the client, including its header set.
+func (c *GraphQLClient) clone() *GraphQLClient {
+	clone := *c
+	clone.headers = make(map[string]string, len(c.headers))
+	for k, v := range c.headers {
+		clone.headers[k] = v
+	}
+	return &clone
+}
+
+// Execute posts a GraphQL operation and decodes the response envelope,
+// retrying connection-level failures when configured.
+func (c *GraphQLClient) Execute(query string, variables map[string]any) (*GraphQLResponse, error) {
+	body, err := json.Marshal(map[string]any{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	var lastErr error
+	backoff := c.backoff
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		resp, err := c.post(body)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// BatchOperation is one operation in a batched GraphQL request.
+type BatchOperation struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// ExecuteBatch posts a JSON array of operations and decodes the array of
+// response envelopes, which the server returns in request order.
+func (c *GraphQLClient) ExecuteBatch(operations []BatchOperation) ([]*GraphQLResponse, error) {
+	body, err := json.Marshal(operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batch request: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/graphql", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var results []*GraphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode batch response: %w", err)
+	}
+	for _, r := range results {
+		r.StatusCode = resp.StatusCode
+	}
+	return results, nil
+}
+
+// post performs a single HTTP round trip.
+func (c *GraphQLClient) post(body []byte) (*GraphQLResponse, error) {
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/graphql", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result GraphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	result.StatusCode = resp.StatusCode
+	return &result, nil
+}
+
+// GraphQLError is one error entry in a GraphQL response.
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// GraphQLResponse is the standard GraphQL response envelope.
+type GraphQLResponse struct {
+	Data       map[string]any `json:"data"`
+	Errors     []GraphQLError `json:"errors,omitempty"`
+	StatusCode int            `json:"-"`
+}
+
+// HasErrors reports whether the response carries any errors.
+func (r *GraphQLResponse) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+// ErrorMessages returns the messages of all errors in the response.
+func (r *GraphQLResponse) ErrorMessages() []string {
+	messages := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		messages = append(messages, e.Message)
+	}
+	return messages
+}
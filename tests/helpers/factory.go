@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// factoryFirstNames and factoryLastNames seed deterministic but varied
+// fixture names.
+var (
+	factoryFirstNames = []string{"Ada", "Grace", "Alan", "Edsger", "Barbara", "Donald", "Radia", "Ken"}
+	factoryLastNames  = []string{"Lovelace", "Hopper", "Turing", "Dijkstra", "Liskov", "Knuth", "Perlman", "Thompson"}
+)
+
+// EmployeeFactory builds valid employees through the real domain
+// constructor, with a seeded RNG so runs are reproducible.
+type EmployeeFactory struct {
+	rng     *rand.Rand
+	counter int
+}
+
+// NewEmployeeFactory creates a factory whose output is fully determined by
+// the seed.
+func NewEmployeeFactory(seed int64) *EmployeeFactory {
+	return &EmployeeFactory{rng: rand.New(rand.NewSource(seed))}
+}
+
+// employeeParams collects the overridable employee fields.
+type employeeParams struct {
+	department string
+	position   string
+	salary     float64
+	status     domain.EmployeeStatus
+	managerID  *uuid.UUID
+	email      string
+}
+
+// EmployeeOption overrides one field on a factory-built employee.
+type EmployeeOption func(*employeeParams)
+
+// WithDepartment sets the employee's department.
+func WithDepartment(department string) EmployeeOption {
+	return func(p *employeeParams) { p.department = department }
+}
+
+// WithPosition sets the employee's position.
+func WithPosition(position string) EmployeeOption {
+	return func(p *employeeParams) { p.position = position }
+}
+
+// WithSalary sets the employee's salary.
+func WithSalary(salary float64) EmployeeOption {
+	return func(p *employeeParams) { p.salary = salary }
+}
+
+// WithStatus sets the employee's status after construction.
+func WithStatus(status domain.EmployeeStatus) EmployeeOption {
+	return func(p *employeeParams) { p.status = status }
+}
+
+// WithManager sets the employee's manager.
+func WithManager(managerID uuid.UUID) EmployeeOption {
+	return func(p *employeeParams) { p.managerID = &managerID }
+}
+
+// WithEmail overrides the generated email.
+func WithEmail(email string) EmployeeOption {
+	return func(p *employeeParams) { p.email = email }
+}
+
+// Build constructs a valid employee, failing the test on constructor errors.
+func (f *EmployeeFactory) Build(t *testing.T, opts ...EmployeeOption) *domain.Employee {
+	t.Helper()
+	f.counter++
+
+	firstName := factoryFirstNames[f.rng.Intn(len(factoryFirstNames))]
+	lastName := factoryLastNames[f.rng.Intn(len(factoryLastNames))]
+	params := employeeParams{
+		department: "Engineering",
+		position:   "Software Engineer",
+		salary:     float64(60000 + f.rng.Intn(80000)),
+		email:      fmt.Sprintf("%s.%s.%d@example.com", firstName, lastName, f.counter),
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
+	hireDate := time.Now().AddDate(-1-f.rng.Intn(5), 0, 0)
+	employee, err := domain.NewEmployee(firstName, lastName, params.email, "",
+		params.department, params.position, params.salary, hireDate, params.managerID)
+	if err != nil {
+		t.Fatalf("failed to build employee: %v", err)
+	}
+	if params.status != "" {
+		employee.Status = params.status
+	}
+	return employee
+}
+
+// UserFactory builds valid users through the real domain constructor, with
+// a seeded RNG so runs are reproducible.
+type UserFactory struct {
+	rng     *rand.Rand
+	counter int
+}
+
+// NewUserFactory creates a factory whose output is fully determined by the
+// seed.
+func NewUserFactory(seed int64) *UserFactory {
+	return &UserFactory{rng: rand.New(rand.NewSource(seed))}
+}
+
+// userParams collects the overridable user fields.
+type userParams struct {
+	username string
+	role     domain.UserRole
+	password string
+	inactive bool
+}
+
+// UserOption overrides one field on a factory-built user.
+type UserOption func(*userParams)
+
+// WithRole sets the user's role.
+func WithRole(role domain.UserRole) UserOption {
+	return func(p *userParams) { p.role = role }
+}
+
+// WithUsername overrides the generated username.
+func WithUsername(username string) UserOption {
+	return func(p *userParams) { p.username = username }
+}
+
+// WithPassword overrides the default fixture password.
+func WithPassword(password string) UserOption {
+	return func(p *userParams) { p.password = password }
+}
+
+// WithInactive marks the user as deactivated.
+func WithInactive() UserOption {
+	return func(p *userParams) { p.inactive = true }
+}
+
+// DefaultFixturePassword satisfies the password policy and is shared by
+// factory-built users unless overridden.
+const DefaultFixturePassword = "Fixture-Passw0rd!"
+
+// Build constructs a valid user, failing the test on constructor errors.
+func (f *UserFactory) Build(t *testing.T, opts ...UserOption) *domain.User {
+	t.Helper()
+	f.counter++
+
+	params := userParams{
+		username: fmt.Sprintf("user_%d_%04d", f.counter, f.rng.Intn(10000)),
+		role:     domain.RoleViewer,
+		password: DefaultFixturePassword,
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
+	user, err := domain.NewUser(params.username, params.username+"@example.com", params.password, params.role)
+	if err != nil {
+		t.Fatalf("failed to build user: %v", err)
+	}
+	if params.inactive {
+		user.IsActive = false
+	}
+	return user
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const randomAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// RandomString returns a random lowercase alphanumeric string of length n.
+func RandomString(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = randomAlphabet[rand.Intn(len(randomAlphabet))]
+	}
+	return string(b)
+}
+
+// RandomEmail returns a unique-looking email address for test fixtures.
+func RandomEmail() string {
+	return fmt.Sprintf("%s@example.com", RandomString(12))
+}
@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/graphql"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// testJWTSecret signs tokens for in-process test servers only.
+const testJWTSecret = "contract-test-secret"
+
+// TestServer boots the real GraphQL handler over in-memory repositories so
+// the contract tests exercise the full request path without Postgres.
+type TestServer struct {
+	BaseURL string
+
+	Employees  *domain.EmployeeService
+	Users      *domain.UserService
+	Tokens     *auth.TokenService
+	EventStore *memory.EventStore
+
+	httpServer  *httptest.Server
+	handlerOpts []graphql.HandlerOption
+}
+
+// TestServerOption customizes the server before it starts, typically to
+// inject a seeded dataset.
+type TestServerOption func(t *testing.T, s *TestServer)
+
+// WithHandlerOptions passes extra options to the GraphQL handler, letting
+// tests tighten limits such as the per-operation timeouts.
+func WithHandlerOptions(opts ...graphql.HandlerOption) TestServerOption {
+	return func(t *testing.T, s *TestServer) {
+		s.handlerOpts = append(s.handlerOpts, opts...)
+	}
+}
+
+// WithSeedUser inserts a user account before the server starts.
+func WithSeedUser(user *domain.User) TestServerOption {
+	return func(t *testing.T, s *TestServer) {
+		t.Helper()
+		created, err := s.Users.CreateUser(context.Background(), user, user.ID, "127.0.0.1", "seed")
+		if err != nil {
+			t.Fatalf("failed to seed user %s: %v", user.Username, err)
+		}
+		_ = created
+	}
+}
+
+// WithSeedEmployee inserts an employee record before the server starts.
+func WithSeedEmployee(employee *domain.Employee) TestServerOption {
+	return func(t *testing.T, s *TestServer) {
+		t.Helper()
+		if _, err := s.Employees.CreateEmployee(context.Background(), employee, "", employee.ID, "127.0.0.1", "seed"); err != nil {
+			t.Fatalf("failed to seed employee %s: %v", employee.Email, err)
+		}
+	}
+}
+
+// NewTestServer wires the domain services onto in-memory repositories,
+// applies the seed options, and starts an HTTP server for the tests.
+func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
+	t.Helper()
+
+	dispatcher := domain.NewEventDispatcher()
+	eventStore := memory.NewEventStore()
+	auditRepo := memory.NewAuditLogRepository()
+	employeeRepo := memory.NewEmployeeRepository()
+	auditRepo.SetEmployeeRepository(employeeRepo)
+	userRepo := memory.NewUserRepository()
+	employeeService := domain.NewEmployeeService(employeeRepo, auditRepo, eventStore, dispatcher)
+	employeeService.SetUserRepository(userRepo)
+	userService := domain.NewUserService(userRepo, auditRepo, dispatcher)
+	userService.SetEmployeeRepository(employeeRepo)
+	tokenService := auth.NewTokenService(testJWTSecret)
+
+	server := &TestServer{
+		Employees:  employeeService,
+		Users:      userService,
+		Tokens:     tokenService,
+		EventStore: eventStore,
+	}
+	for _, opt := range opts {
+		opt(t, server)
+	}
+
+	resolver := graphql.NewResolver(employeeService, userService, tokenService)
+	handlerOpts := append([]graphql.HandlerOption{graphql.WithAuthCookie()}, server.handlerOpts...)
+	handler, err := graphql.NewHandler(resolver, tokenService, handlerOpts...)
+	if err != nil {
+		t.Fatalf("failed to build GraphQL handler: %v", err)
+	}
+	server.httpServer = httptest.NewServer(graphql.SecurityHeadersMiddleware(graphql.DefaultSecurityHeadersConfig(), handler))
+	server.BaseURL = server.httpServer.URL
+	return server
+}
+
+// Close shuts the HTTP server down.
+func (s *TestServer) Close() {
+	if s.httpServer != nil {
+		s.httpServer.Close()
+	}
+}
+
+// Client returns a GraphQL client targeting this server.
+func (s *TestServer) Client() *GraphQLClient {
+	return NewGraphQLClient(s.BaseURL)
+}
+
+// TokenFor issues an access token for the given user, so tests can make
+// authenticated requests without running the login mutation.
+func (s *TestServer) TokenFor(t *testing.T, user *domain.User) string {
+	t.Helper()
+	token, err := s.Tokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("failed to issue token: %v", err)
+	}
+	return token
+}
+
+// fixedClock is an auth.Clock pinned to a single instant.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+// ExpiredTokenFor issues a token that has already expired, by winding the
+// token service's clock back past the access TTL for the issuance.
+func (s *TestServer) ExpiredTokenFor(t *testing.T, user *domain.User) string {
+	t.Helper()
+	s.Tokens.SetClock(fixedClock(time.Now().Add(-2 * auth.AccessTokenTTL)))
+	defer s.Tokens.SetClock(nil)
+	return s.TokenFor(t, user)
+}
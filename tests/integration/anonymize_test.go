@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// newAnonymizeFixture wires an employee service over in-memory stores with
+// one employee that has a PII-bearing audit history.
+func newAnonymizeFixture(t *testing.T) (*domain.EmployeeService, *memory.AuditLogRepository, *domain.Employee, uuid.UUID) {
+	t.Helper()
+	dispatcher := domain.NewEventDispatcher()
+	eventStore := memory.NewEventStore()
+	auditRepo := memory.NewAuditLogRepository()
+	employeeRepo := memory.NewEmployeeRepository()
+	auditRepo.SetEmployeeRepository(employeeRepo)
+	service := domain.NewEmployeeService(employeeRepo, auditRepo, eventStore, dispatcher)
+
+	ctx := context.Background()
+	actor := uuid.New()
+	employee, err := domain.NewEmployee("Jane", "Doe", "jane.doe@example.com", "555-0100",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	if _, err := service.CreateEmployee(ctx, employee, "", actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateEmployee: %v", err)
+	}
+	if _, err := service.UpdateEmployee(ctx, employee.ID, map[string]any{"phone": "555-0199"},
+		actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("UpdateEmployee: %v", err)
+	}
+	return service, auditRepo, employee, actor
+}
+
+func TestAnonymizeEmployeeScrubsRecordAndSnapshots(t *testing.T) {
+	service, auditRepo, employee, actor := newAnonymizeFixture(t)
+	ctx := context.Background()
+
+	got, err := service.AnonymizeEmployee(ctx, employee.ID, true, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("AnonymizeEmployee: %v", err)
+	}
+	if got.FirstName != domain.AnonymizedValue || got.LastName != domain.AnonymizedValue {
+		t.Errorf("name = %s %s, want tombstones", got.FirstName, got.LastName)
+	}
+	if strings.Contains(got.Email, "jane") {
+		t.Errorf("email %q still carries PII", got.Email)
+	}
+	if got.Phone != "" || got.Address != nil {
+		t.Errorf("phone/address not erased: %q %+v", got.Phone, got.Address)
+	}
+
+	stored, err := service.GetEmployee(ctx, employee.ID)
+	if err != nil {
+		t.Fatalf("GetEmployee: %v", err)
+	}
+	if stored.FirstName != domain.AnonymizedValue {
+		t.Errorf("stored first name = %q, want tombstone", stored.FirstName)
+	}
+
+	logs, _, err := auditRepo.List(ctx, domain.AuditLogFilter{EmployeeID: &employee.ID}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List audit logs: %v", err)
+	}
+	var sawErasure bool
+	for _, log := range logs {
+		if log.Operation == domain.OperationAnonymizeEmployee {
+			sawErasure = true
+			continue
+		}
+		for _, values := range []map[string]any{log.OldValues, log.NewValues} {
+			if values == nil {
+				continue
+			}
+			if v, ok := values["firstName"]; ok && v != domain.AnonymizedValue {
+				t.Errorf("%s snapshot firstName = %v, want tombstone", log.Operation, v)
+			}
+			if v, ok := values["salary"]; ok && v != float64(90000) {
+				t.Errorf("%s snapshot salary = %v, want 90000 preserved", log.Operation, v)
+			}
+		}
+	}
+	if !sawErasure {
+		t.Error("no ANONYMIZE_EMPLOYEE audit entry recorded")
+	}
+}
+
+func TestAnonymizeEmployeeKeepsSnapshotsWithoutScrub(t *testing.T) {
+	service, auditRepo, employee, actor := newAnonymizeFixture(t)
+	ctx := context.Background()
+
+	if _, err := service.AnonymizeEmployee(ctx, employee.ID, false, actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("AnonymizeEmployee: %v", err)
+	}
+
+	logs, _, err := auditRepo.List(ctx, domain.AuditLogFilter{
+		EmployeeID: &employee.ID, Operation: domain.OperationCreateEmployee}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List audit logs: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("got %d creation logs, want 1", len(logs))
+	}
+	if logs[0].NewValues["firstName"] != "Jane" {
+		t.Errorf("creation snapshot firstName = %v, want untouched history", logs[0].NewValues["firstName"])
+	}
+}
@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// seedAuditLogs inserts count logs with strictly increasing timestamps and
+// returns them newest first, matching the repository's list order.
+func seedAuditLogs(t *testing.T, repo *memory.AuditLogRepository, count int) []*domain.AuditLog {
+	t.Helper()
+	base := time.Now().Add(-time.Hour)
+	logs := make([]*domain.AuditLog, count)
+	for i := 0; i < count; i++ {
+		userID := uuid.New()
+		log, err := domain.NewAuditLog(&userID, nil, domain.OperationLogin, nil, nil, "127.0.0.1", "test")
+		if err != nil {
+			t.Fatalf("NewAuditLog: %v", err)
+		}
+		log.Timestamp = base.Add(time.Duration(i) * time.Minute)
+		if err := repo.Create(context.Background(), log); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		logs[count-1-i] = log
+	}
+	return logs
+}
+
+func TestAuditLogKeysetPaginationStableAcrossInserts(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAuditLogRepository()
+	logs := seedAuditLogs(t, repo, 9)
+
+	page1, _, err := repo.List(ctx, domain.AuditLogFilter{}, domain.Pagination{Limit: 3})
+	if err != nil {
+		t.Fatalf("List page 1: %v", err)
+	}
+	if len(page1) != 3 {
+		t.Fatalf("page 1 size = %d, want 3", len(page1))
+	}
+	cursor := domain.EncodeAuditCursor(page1[2].Timestamp, page1[2].ID)
+
+	// A log written between page fetches lands ahead of the cursor and must
+	// not shift the next page.
+	userID := uuid.New()
+	newest, err := domain.NewAuditLog(&userID, nil, domain.OperationLogin, nil, nil, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("NewAuditLog: %v", err)
+	}
+	if err := repo.Create(ctx, newest); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	page2, _, err := repo.List(ctx, domain.AuditLogFilter{}, domain.Pagination{Limit: 3, After: cursor})
+	if err != nil {
+		t.Fatalf("List page 2: %v", err)
+	}
+	if len(page2) != 3 {
+		t.Fatalf("page 2 size = %d, want 3", len(page2))
+	}
+	for i, want := range logs[3:6] {
+		if page2[i].ID != want.ID {
+			t.Errorf("page 2 entry %d = %s, want %s", i, page2[i].ID, want.ID)
+		}
+	}
+	for _, earlier := range page1 {
+		for _, got := range page2 {
+			if got.ID == earlier.ID {
+				t.Errorf("page 2 repeats entry %s from page 1", got.ID)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// TestAuditSnapshotFieldWhitelist verifies a configured whitelist trims the
+// snapshots of that operation while others keep full snapshots.
+func TestAuditSnapshotFieldWhitelist(t *testing.T) {
+	dispatcher := domain.NewEventDispatcher()
+	eventStore := memory.NewEventStore()
+	auditRepo := memory.NewAuditLogRepository()
+	employeeRepo := memory.NewEmployeeRepository()
+	auditRepo.SetEmployeeRepository(employeeRepo)
+	service := domain.NewEmployeeService(employeeRepo, auditRepo, eventStore, dispatcher)
+	service.SetAuditSnapshotFields(domain.OperationUpdateEmployee, "department", "position")
+
+	ctx := context.Background()
+	actor := uuid.New()
+	employee, err := domain.NewEmployee("Noor", "Haddad", "noor.haddad@example.com", "",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	if _, err := service.CreateEmployee(ctx, employee, "", actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateEmployee: %v", err)
+	}
+	if _, err := service.UpdateEmployee(ctx, employee.ID, map[string]any{"department": "Platform"},
+		actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("UpdateEmployee: %v", err)
+	}
+
+	logs, _, err := auditRepo.List(ctx, domain.AuditLogFilter{
+		EmployeeID: &employee.ID, Operation: domain.OperationUpdateEmployee}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List audit logs: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("got %d update logs, want 1", len(logs))
+	}
+	for field := range logs[0].OldValues {
+		if field != "department" && field != "position" {
+			t.Errorf("old snapshot carries non-whitelisted field %q", field)
+		}
+	}
+	if logs[0].OldValues["department"] != "Engineering" || logs[0].NewValues["department"] != "Platform" {
+		t.Errorf("snapshots = %v -> %v, want the department change captured",
+			logs[0].OldValues, logs[0].NewValues)
+	}
+
+	// The creation log is unaffected: no whitelist was configured for it.
+	created, _, err := auditRepo.List(ctx, domain.AuditLogFilter{
+		EmployeeID: &employee.ID, Operation: domain.OperationCreateEmployee}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List creation logs: %v", err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("got %d creation logs, want 1", len(created))
+	}
+	if created[0].NewValues["salary"] != float64(90000) {
+		t.Errorf("creation snapshot = %v, want full snapshot including salary", created[0].NewValues)
+	}
+}
@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// TestListWithoutSortIsDeterministic pins the default-sort contract: an
+// empty EmployeeSort means newest first with ID as a tiebreaker, so two
+// unsorted calls return rows in the same order.
+func TestListWithoutSortIsDeterministic(t *testing.T) {
+	repo := seedListEmployees(t, 8)
+	ctx := context.Background()
+
+	first, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 8})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(first.Employees) != 8 {
+		t.Fatalf("got %d employees, want 8", len(first.Employees))
+	}
+	for i := 1; i < len(first.Employees); i++ {
+		prev, cur := first.Employees[i-1], first.Employees[i]
+		if cur.CreatedAt.After(prev.CreatedAt) {
+			t.Errorf("entry %d created %v after entry %d (%v); want newest first",
+				i, cur.CreatedAt, i-1, prev.CreatedAt)
+		}
+	}
+
+	second, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 8})
+	if err != nil {
+		t.Fatalf("List (repeat): %v", err)
+	}
+	for i := range first.Employees {
+		if first.Employees[i].ID != second.Employees[i].ID {
+			t.Fatalf("entry %d differs between calls: %s vs %s",
+				i, first.Employees[i].ID, second.Employees[i].ID)
+		}
+	}
+}
+
+// TestListDefaultSortBreaksTiesByID seeds employees sharing a created_at
+// and checks the order still repeats across calls.
+func TestListDefaultSortBreaksTiesByID(t *testing.T) {
+	repo := seedListEmployees(t, 6)
+	ctx := context.Background()
+
+	// Flatten creation times so only the ID tiebreaker can order them.
+	all, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 6})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	shared := time.Now().UTC()
+	for _, e := range all.Employees {
+		e.CreatedAt = shared
+		if err := repo.Update(ctx, e); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+
+	first, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 6})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	second, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 6})
+	if err != nil {
+		t.Fatalf("List (repeat): %v", err)
+	}
+	for i := range first.Employees {
+		if first.Employees[i].ID != second.Employees[i].ID {
+			t.Fatalf("entry %d differs between calls: %s vs %s",
+				i, first.Employees[i].ID, second.Employees[i].ID)
+		}
+	}
+}
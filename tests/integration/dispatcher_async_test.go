@@ -0,0 +1,66 @@
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestAsyncDispatchDeliversAllEventsOnDrain(t *testing.T) {
+	dispatcher := domain.NewEventDispatcher()
+
+	var mu sync.Mutex
+	var received []string
+	dispatcher.Subscribe("", func(ctx context.Context, event domain.DomainEvent) {
+		mu.Lock()
+		received = append(received, event.EventType())
+		mu.Unlock()
+	})
+
+	dispatcher.StartAsync(2, 16)
+	ctx := context.Background()
+	for i := 0; i < 25; i++ {
+		dispatcher.Dispatch(ctx, domain.NewEmployeeDeletedEvent(uuid.New()))
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := dispatcher.Drain(drainCtx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 25 {
+		t.Fatalf("handlers saw %d events, want 25", len(received))
+	}
+	if depth := dispatcher.QueueDepth(); depth != 0 {
+		t.Fatalf("queue depth after drain = %d, want 0", depth)
+	}
+}
+
+func TestDispatchAfterDrainFallsBackToSynchronous(t *testing.T) {
+	dispatcher := domain.NewEventDispatcher()
+	delivered := false
+	dispatcher.Subscribe("", func(ctx context.Context, event domain.DomainEvent) {
+		delivered = true
+	})
+
+	dispatcher.StartAsync(1, 4)
+	ctx := context.Background()
+	drainCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := dispatcher.Drain(drainCtx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+
+	dispatcher.Dispatch(ctx, domain.NewEmployeeDeletedEvent(uuid.New()))
+	if !delivered {
+		t.Fatal("event dispatched after drain was not delivered synchronously")
+	}
+}
@@ -0,0 +1,193 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// seedDepartment creates count employees in the given department.
+func seedDepartment(t *testing.T, service *domain.EmployeeService, department string, count int) {
+	t.Helper()
+	actor := uuid.New()
+	for i := 0; i < count; i++ {
+		employee, err := domain.NewEmployee("Employee", "Number"+string(rune('A'+i)),
+			string(rune('a'+i))+"."+department+"@example.com", "",
+			department, "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+		if err != nil {
+			t.Fatalf("NewEmployee: %v", err)
+		}
+		if _, err := service.CreateEmployee(context.Background(), employee, "", actor, "127.0.0.1", "test"); err != nil {
+			t.Fatalf("CreateEmployee: %v", err)
+		}
+	}
+}
+
+func TestUpdateEmployeesByFilter(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 3)
+	seedDepartment(t, service, "sales", 2)
+	actor := uuid.New()
+
+	changed, err := service.UpdateEmployeesByFilter(ctx, domain.EmployeeFilter{Department: "engineering"},
+		map[string]any{"position": "Senior Engineer"}, domain.BulkOptions{}, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("UpdateEmployeesByFilter: %v", err)
+	}
+	if changed != 3 {
+		t.Fatalf("changed = %d, want 3", changed)
+	}
+
+	result, err := service.ListEmployees(ctx, domain.EmployeeFilter{Department: "sales"}, domain.EmployeeSort{}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListEmployees: %v", err)
+	}
+	for _, e := range result.Employees {
+		if e.Position != "Engineer" {
+			t.Fatalf("sales employee position = %q, want untouched", e.Position)
+		}
+	}
+}
+
+func TestUpdateEmployeesByFilterRequiresConfirmForMatchAll(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 2)
+	actor := uuid.New()
+
+	_, err := service.UpdateEmployeesByFilter(ctx, domain.EmployeeFilter{},
+		map[string]any{"position": "Senior Engineer"}, domain.BulkOptions{}, actor, "127.0.0.1", "test")
+	if !errors.Is(err, domain.ErrBulkUpdateNotConfirmed) {
+		t.Fatalf("err = %v, want ErrBulkUpdateNotConfirmed", err)
+	}
+
+	changed, err := service.UpdateEmployeesByFilter(ctx, domain.EmployeeFilter{},
+		map[string]any{"position": "Senior Engineer"}, domain.BulkOptions{ConfirmAll: true}, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("UpdateEmployeesByFilter (confirmed): %v", err)
+	}
+	if changed != 2 {
+		t.Fatalf("changed = %d, want 2", changed)
+	}
+}
+
+func TestAdjustSalaryByPercent(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 2)
+	actor := uuid.New()
+
+	result, err := service.ListEmployees(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListEmployees: %v", err)
+	}
+	var ids []uuid.UUID
+	for _, e := range result.Employees {
+		ids = append(ids, e.ID)
+	}
+
+	changed, err := service.AdjustSalaryByPercent(ctx, ids, 3.5, domain.BulkOptions{}, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("AdjustSalaryByPercent: %v", err)
+	}
+	if changed != 2 {
+		t.Fatalf("changed = %d, want 2", changed)
+	}
+	for _, id := range ids {
+		employee, err := service.GetEmployee(ctx, id)
+		if err != nil {
+			t.Fatalf("GetEmployee: %v", err)
+		}
+		if employee.Salary != 93150 {
+			t.Fatalf("salary = %.2f, want 93150.00", employee.Salary)
+		}
+	}
+
+	// An adjustment that would push any employee past the salary bounds
+	// aborts the whole batch.
+	_, err = service.AdjustSalaryByPercent(ctx, ids, 10_000, domain.BulkOptions{}, actor, "127.0.0.1", "test")
+	if !errors.Is(err, domain.ErrSalaryTooHigh) {
+		t.Fatalf("err = %v, want ErrSalaryTooHigh", err)
+	}
+	employee, err := service.GetEmployee(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("GetEmployee: %v", err)
+	}
+	if employee.Salary != 93150 {
+		t.Fatalf("salary = %.2f after rejected batch, want 93150.00", employee.Salary)
+	}
+}
+
+func TestBulkOperationsDryRunWritesNothing(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 3)
+	actor := uuid.New()
+
+	changed, err := service.UpdateEmployeesByFilter(ctx, domain.EmployeeFilter{Department: "engineering"},
+		map[string]any{"position": "Senior Engineer"}, domain.BulkOptions{DryRun: true}, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("UpdateEmployeesByFilter (dry run): %v", err)
+	}
+	if changed != 3 {
+		t.Fatalf("dry-run changed = %d, want 3", changed)
+	}
+
+	result, err := service.ListEmployees(ctx, domain.EmployeeFilter{Department: "engineering"}, domain.EmployeeSort{}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListEmployees: %v", err)
+	}
+	var ids []uuid.UUID
+	for _, e := range result.Employees {
+		if e.Position != "Engineer" || e.Version != 1 {
+			t.Fatalf("employee %s modified by dry run", e.ID)
+		}
+		ids = append(ids, e.ID)
+	}
+
+	changed, err = service.AdjustSalaryByPercent(ctx, ids, 10, domain.BulkOptions{DryRun: true}, actor, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("AdjustSalaryByPercent (dry run): %v", err)
+	}
+	if changed != 3 {
+		t.Fatalf("dry-run changed = %d, want 3", changed)
+	}
+	for _, id := range ids {
+		employee, err := service.GetEmployee(ctx, id)
+		if err != nil {
+			t.Fatalf("GetEmployee: %v", err)
+		}
+		if employee.Salary != 90000 {
+			t.Fatalf("salary = %.2f after dry run, want 90000.00", employee.Salary)
+		}
+	}
+}
+
+func TestUpdateEmployeesByFilterInvalidUpdateTouchesNothing(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 2)
+	actor := uuid.New()
+
+	_, err := service.UpdateEmployeesByFilter(ctx, domain.EmployeeFilter{Department: "engineering"},
+		map[string]any{"email": "not-an-email"}, domain.BulkOptions{}, actor, "127.0.0.1", "test")
+	if err == nil {
+		t.Fatal("UpdateEmployeesByFilter: expected validation error, got nil")
+	}
+
+	result, err := service.ListEmployees(ctx, domain.EmployeeFilter{Department: "engineering"}, domain.EmployeeSort{}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListEmployees: %v", err)
+	}
+	for _, e := range result.Employees {
+		if e.Version != 1 {
+			t.Fatalf("employee %s version = %d, want unchanged", e.ID, e.Version)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// newExportFixture wires an employee service with one employee whose audit
+// trail includes a salary change and a department move, plus a user account
+// linked to the record.
+func newExportFixture(t *testing.T) (*domain.EmployeeService, *domain.Employee, *domain.User, uuid.UUID) {
+	t.Helper()
+	dispatcher := domain.NewEventDispatcher()
+	eventStore := memory.NewEventStore()
+	auditRepo := memory.NewAuditLogRepository()
+	employeeRepo := memory.NewEmployeeRepository()
+	auditRepo.SetEmployeeRepository(employeeRepo)
+	userRepo := memory.NewUserRepository()
+	service := domain.NewEmployeeService(employeeRepo, auditRepo, eventStore, dispatcher)
+	service.SetUserRepository(userRepo)
+
+	ctx := context.Background()
+	actor := uuid.New()
+	employee, err := domain.NewEmployee("Mara", "Chen", "mara.chen@example.com", "",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-2, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	if _, err := service.CreateEmployee(ctx, employee, "", actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateEmployee: %v", err)
+	}
+	if _, err := service.UpdateSalary(ctx, employee.ID, 95000, actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("UpdateSalary: %v", err)
+	}
+	if _, err := service.UpdateEmployee(ctx, employee.ID, map[string]any{"department": "Platform"},
+		actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("UpdateEmployee: %v", err)
+	}
+
+	subject, err := domain.NewUser("mchen", "mara.chen@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if err := userRepo.Create(ctx, subject); err != nil {
+		t.Fatalf("Create user: %v", err)
+	}
+	if _, err := service.LinkEmployeeToUser(ctx, employee.ID, subject.ID, actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("LinkEmployeeToUser: %v", err)
+	}
+	return service, employee, subject, actor
+}
+
+func TestExportEmployeeDataAssemblesHistories(t *testing.T) {
+	service, employee, _, _ := newExportFixture(t)
+	ctx := context.Background()
+
+	admin, err := domain.NewUser("export_admin", "export_admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	pkg, err := service.ExportEmployeeData(ctx, employee.ID, admin)
+	if err != nil {
+		t.Fatalf("ExportEmployeeData: %v", err)
+	}
+
+	if pkg.Employee == nil || pkg.Employee.ID != employee.ID {
+		t.Fatalf("package employee = %+v, want %s", pkg.Employee, employee.ID)
+	}
+	if len(pkg.SalaryHistory) != 2 {
+		t.Fatalf("salary history has %d entries, want 2 (initial + change)", len(pkg.SalaryHistory))
+	}
+	if pkg.SalaryHistory[0].NewValue != float64(90000) || pkg.SalaryHistory[1].NewValue != float64(95000) {
+		t.Errorf("salary history = %+v, want 90000 then 95000", pkg.SalaryHistory)
+	}
+	if len(pkg.DepartmentHistory) != 2 {
+		t.Fatalf("department history has %d entries, want 2", len(pkg.DepartmentHistory))
+	}
+	if pkg.DepartmentHistory[1].OldValue != "Engineering" || pkg.DepartmentHistory[1].NewValue != "Platform" {
+		t.Errorf("department change = %+v, want Engineering -> Platform", pkg.DepartmentHistory[1])
+	}
+	if len(pkg.AuditLogs) == 0 {
+		t.Error("package carries no audit logs")
+	}
+	if _, err := json.Marshal(pkg); err != nil {
+		t.Errorf("package does not serialize: %v", err)
+	}
+}
+
+func TestExportEmployeeDataRestrictsAccess(t *testing.T) {
+	service, employee, subject, _ := newExportFixture(t)
+	ctx := context.Background()
+
+	// The linked subject may export their own data.
+	if _, err := service.ExportEmployeeData(ctx, employee.ID, subject); err != nil {
+		t.Fatalf("ExportEmployeeData (subject): %v", err)
+	}
+
+	// An unrelated non-admin may not.
+	other, err := domain.NewUser("other_user", "other.user@example.com", "Str0ng-Passw0rd!", domain.RoleHR)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if _, err := service.ExportEmployeeData(ctx, employee.ID, other); !errors.Is(err, domain.ErrUnauthorized) {
+		t.Fatalf("ExportEmployeeData (other): err = %v, want ErrUnauthorized", err)
+	}
+}
@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// newEmployeeService wires an EmployeeService over in-memory repositories.
+func newEmployeeService(t *testing.T) *domain.EmployeeService {
+	t.Helper()
+	return domain.NewEmployeeService(
+		memory.NewEmployeeRepository(),
+		memory.NewAuditLogRepository(),
+		memory.NewEventStore(),
+		domain.NewEventDispatcher(),
+	)
+}
+
+func TestUpdateEmployeeRejectedUpdateLeavesRecordUnchanged(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+
+	employee, err := domain.NewEmployee("Jane", "Doe", "jane.doe@example.com", "",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	actor := uuid.New()
+	if _, err := service.CreateEmployee(ctx, employee, "", actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateEmployee: %v", err)
+	}
+
+	// One acceptable field alongside one that fails validation: the whole
+	// update must be rejected without touching the stored record.
+	_, err = service.UpdateEmployee(ctx, employee.ID, map[string]any{
+		"firstName": "Janet",
+		"email":     "not-an-email",
+	}, actor, "127.0.0.1", "test")
+	if err == nil {
+		t.Fatal("UpdateEmployee: expected validation error, got nil")
+	}
+
+	stored, err := service.GetEmployee(ctx, employee.ID)
+	if err != nil {
+		t.Fatalf("GetEmployee: %v", err)
+	}
+	if stored.FirstName != "Jane" {
+		t.Errorf("FirstName = %q after failed update, want %q", stored.FirstName, "Jane")
+	}
+	if stored.Email != "jane.doe@example.com" {
+		t.Errorf("Email = %q after failed update, want %q", stored.Email, "jane.doe@example.com")
+	}
+	if stored.Version != employee.Version {
+		t.Errorf("Version = %d after failed update, want %d", stored.Version, employee.Version)
+	}
+}
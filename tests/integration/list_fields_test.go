@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestListFieldsPopulatesOnlyRequestedFields(t *testing.T) {
+	repo := seedListEmployees(t, 3)
+	ctx := context.Background()
+
+	result, err := repo.ListFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 10}, []string{"firstName", "email"})
+	if err != nil {
+		t.Fatalf("ListFields: %v", err)
+	}
+	if len(result.Employees) != 3 {
+		t.Fatalf("listed %d employees, want 3", len(result.Employees))
+	}
+	for _, e := range result.Employees {
+		if e.ID == uuid.Nil {
+			t.Error("projected employee is missing its ID")
+		}
+		if e.FirstName == "" || e.Email == "" {
+			t.Errorf("requested fields not populated: %+v", e)
+		}
+		if e.LastName != "" || e.Salary != 0 {
+			t.Errorf("unrequested fields populated: lastName=%q salary=%v", e.LastName, e.Salary)
+		}
+	}
+}
+
+func TestListEmployeesFieldsDropsSalaryWithoutPermission(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 2)
+
+	viewer := &domain.User{Role: domain.RoleViewer}
+	result, err := service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 10}, []string{"firstName", "salary"}, viewer)
+	if err != nil {
+		t.Fatalf("ListEmployeesFields: %v", err)
+	}
+	for _, e := range result.Employees {
+		if e.Salary != 0 {
+			t.Fatalf("salary selected for a viewer: %v", e.Salary)
+		}
+	}
+
+	hr := &domain.User{Role: domain.RoleHR}
+	result, err = service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 10}, []string{"firstName", "salary"}, hr)
+	if err != nil {
+		t.Fatalf("ListEmployeesFields: %v", err)
+	}
+	for _, e := range result.Employees {
+		if e.Salary != 90000 {
+			t.Fatalf("salary = %v for HR, want 90000", e.Salary)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// newLoginFailureFixture builds a user service with an inspectable audit
+// repository and a capture of dispatched LoginFailedEvents.
+func newLoginFailureFixture(t *testing.T) (*domain.UserService, *memory.AuditLogRepository, *[]*domain.LoginFailedEvent) {
+	t.Helper()
+	auditRepo := memory.NewAuditLogRepository()
+	dispatcher := domain.NewEventDispatcher()
+	var events []*domain.LoginFailedEvent
+	dispatcher.Subscribe("LoginFailed", func(ctx context.Context, event domain.DomainEvent) {
+		events = append(events, event.(*domain.LoginFailedEvent))
+	})
+	service := domain.NewUserService(memory.NewUserRepository(), auditRepo, dispatcher)
+	return service, auditRepo, &events
+}
+
+func TestFailedLoginUnknownUserWritesAuditLog(t *testing.T) {
+	ctx := context.Background()
+	service, auditRepo, events := newLoginFailureFixture(t)
+
+	_, err := service.AuthenticateUser(ctx, "no_such_user", "Wrong-Passw0rd!", "127.0.0.1", "test")
+	if !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+
+	logs, _, err := auditRepo.List(ctx, domain.AuditLogFilter{Operation: domain.OperationLoginFailed}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List audit logs: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("got %d LOGIN_FAILED logs, want 1", len(logs))
+	}
+	if logs[0].UserID != nil {
+		t.Errorf("UserID = %v for an unknown user, want nil", logs[0].UserID)
+	}
+	if got := logs[0].NewValues["reason"]; got != domain.LoginFailureInvalidCredentials {
+		t.Errorf("reason = %v, want %s", got, domain.LoginFailureInvalidCredentials)
+	}
+
+	if len(*events) != 1 {
+		t.Fatalf("got %d LoginFailedEvents, want 1", len(*events))
+	}
+	event := (*events)[0]
+	if event.AggregateID() != uuid.Nil {
+		t.Errorf("event aggregate = %v for an unknown user, want uuid.Nil", event.AggregateID())
+	}
+	if event.Username != "no_such_user" || event.IPAddress != "127.0.0.1" {
+		t.Errorf("event = %+v, want username/IP captured", event)
+	}
+}
+
+func TestFailedLoginInactiveUserRecordsReason(t *testing.T) {
+	ctx := context.Background()
+	service, auditRepo, events := newLoginFailureFixture(t)
+
+	user, err := domain.NewUser("dormant", "dormant@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	user.IsActive = false
+	actor := uuid.New()
+	if _, err := service.CreateUser(ctx, user, actor, "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	_, err = service.AuthenticateUser(ctx, "dormant", "Str0ng-Passw0rd!", "127.0.0.1", "test")
+	if !errors.Is(err, domain.ErrUserInactive) {
+		t.Fatalf("err = %v, want ErrUserInactive", err)
+	}
+
+	logs, _, err := auditRepo.List(ctx, domain.AuditLogFilter{Operation: domain.OperationLoginFailed}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List audit logs: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("got %d LOGIN_FAILED logs, want 1", len(logs))
+	}
+	if logs[0].UserID == nil || *logs[0].UserID != user.ID {
+		t.Errorf("UserID = %v, want the inactive account's ID", logs[0].UserID)
+	}
+	if got := logs[0].NewValues["reason"]; got != domain.LoginFailureInactive {
+		t.Errorf("reason = %v, want %s", got, domain.LoginFailureInactive)
+	}
+	if len(*events) != 1 || (*events)[0].Reason != domain.LoginFailureInactive {
+		t.Fatalf("events = %+v, want one with reason %s", *events, domain.LoginFailureInactive)
+	}
+}
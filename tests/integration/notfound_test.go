@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// TestGetByIDNotFound pins the repository contract: Get methods return the
+// entity's not-found sentinel for a missing ID rather than (nil, nil).
+func TestGetByIDNotFound(t *testing.T) {
+	ctx := context.Background()
+	missing := uuid.New()
+
+	if _, err := memory.NewEmployeeRepository().GetByID(ctx, missing); !errors.Is(err, domain.ErrEmployeeNotFound) {
+		t.Fatalf("employee GetByID: err = %v, want ErrEmployeeNotFound", err)
+	}
+	if _, err := memory.NewUserRepository().GetByID(ctx, missing); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("user GetByID: err = %v, want ErrUserNotFound", err)
+	}
+	if _, err := memory.NewDepartmentRepository().GetByID(ctx, missing); !errors.Is(err, domain.ErrDepartmentNotFound) {
+		t.Fatalf("department GetByID: err = %v, want ErrDepartmentNotFound", err)
+	}
+	if _, err := memory.NewPositionRepository().GetByID(ctx, missing); !errors.Is(err, domain.ErrPositionNotFound) {
+		t.Fatalf("position GetByID: err = %v, want ErrPositionNotFound", err)
+	}
+}
+
+// TestFindReturnsNilNilForMissing pins the complementary side: Find methods
+// are optional lookups and report a miss as (nil, nil), not an error.
+func TestFindReturnsNilNilForMissing(t *testing.T) {
+	ctx := context.Background()
+
+	user, err := memory.NewUserRepository().FindByUsername(ctx, "no-such-user")
+	if err != nil {
+		t.Fatalf("FindByUsername: %v", err)
+	}
+	if user != nil {
+		t.Fatalf("FindByUsername: got %+v, want nil", user)
+	}
+
+	employee, err := memory.NewEmployeeRepository().FindByUserID(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("FindByUserID: %v", err)
+	}
+	if employee != nil {
+		t.Fatalf("FindByUserID: got %+v, want nil", employee)
+	}
+}
@@ -0,0 +1,70 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/notify"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+func TestNotifierSendsTemplatedMessageOnEmployeeCreated(t *testing.T) {
+	var sent []notify.Message
+	service := notify.NewService(notify.ChannelFunc(func(ctx context.Context, msg notify.Message) error {
+		sent = append(sent, msg)
+		return nil
+	}))
+	err := service.AddRule("EmployeeCreated", notify.Rule{
+		Recipients:      []string{"hr@example.com"},
+		SubjectTemplate: "New employee: {{.Employee.FullName}}",
+		BodyTemplate:    "{{.Employee.FullName}} joined {{.Employee.Department}}.",
+	})
+	if err != nil {
+		t.Fatalf("AddRule: %v", err)
+	}
+
+	dispatcher := domain.NewEventDispatcher()
+	domain.SubscribeNotifier(dispatcher, service, service.EventTypes()...)
+	employees := domain.NewEmployeeService(
+		memory.NewEmployeeRepository(),
+		memory.NewAuditLogRepository(),
+		memory.NewEventStore(),
+		dispatcher,
+	)
+
+	employee, err := domain.NewEmployee("Jane", "Doe", "jane.doe@example.com", "",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	if _, err := employees.CreateEmployee(context.Background(), employee, "", uuid.New(), "127.0.0.1", "test"); err != nil {
+		t.Fatalf("CreateEmployee: %v", err)
+	}
+
+	if len(sent) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(sent))
+	}
+	msg := sent[0]
+	if len(msg.Recipients) != 1 || msg.Recipients[0] != "hr@example.com" {
+		t.Fatalf("recipients = %v, want [hr@example.com]", msg.Recipients)
+	}
+	if msg.Subject != "New employee: Jane Doe" {
+		t.Fatalf("subject = %q", msg.Subject)
+	}
+	if msg.Body != "Jane Doe joined Engineering." {
+		t.Fatalf("body = %q", msg.Body)
+	}
+
+	// Events without a rule are ignored.
+	if _, err := employees.UpdateEmployee(context.Background(), employee.ID,
+		map[string]any{"position": "Senior Engineer"}, uuid.New(), "127.0.0.1", "test"); err != nil {
+		t.Fatalf("UpdateEmployee: %v", err)
+	}
+	if len(sent) != 1 {
+		t.Fatalf("sent %d messages after unrelated event, want 1", len(sent))
+	}
+}
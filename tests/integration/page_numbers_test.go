@@ -0,0 +1,66 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+// seedListEmployees inserts count employees and returns the repository.
+func seedListEmployees(t *testing.T, count int) *memory.EmployeeRepository {
+	t.Helper()
+	repo := memory.NewEmployeeRepository()
+	ctx := context.Background()
+	for i := 0; i < count; i++ {
+		employee, err := domain.NewEmployee("Page", fmt.Sprintf("Number%c", rune('A'+i)),
+			fmt.Sprintf("page.number%d@example.com", i), "", "Engineering", "Engineer",
+			90000, time.Now().AddDate(-1, 0, 0), nil)
+		if err != nil {
+			t.Fatalf("NewEmployee: %v", err)
+		}
+		if err := repo.Create(ctx, employee); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	return repo
+}
+
+func TestListOffsetModePopulatesPageNumbers(t *testing.T) {
+	repo := seedListEmployees(t, 7)
+	ctx := context.Background()
+
+	result, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 3, Offset: 3})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if result.TotalPages != 3 {
+		t.Errorf("TotalPages = %d, want 3", result.TotalPages)
+	}
+	if result.CurrentPage != 2 {
+		t.Errorf("CurrentPage = %d, want 2", result.CurrentPage)
+	}
+}
+
+func TestListCursorModeLeavesPageNumbersZero(t *testing.T) {
+	repo := seedListEmployees(t, 7)
+	ctx := context.Background()
+
+	first, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 3})
+	if err != nil {
+		t.Fatalf("List page 1: %v", err)
+	}
+	result, err := repo.List(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 3, After: first.PageInfo.EndCursor})
+	if err != nil {
+		t.Fatalf("List page 2: %v", err)
+	}
+	if result.TotalPages != 0 || result.CurrentPage != 0 {
+		t.Errorf("cursor mode page numbers = %d/%d, want 0/0", result.CurrentPage, result.TotalPages)
+	}
+}
@@ -0,0 +1,53 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestListEmployeesRejectsOversizedPagePerRole(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 2)
+
+	viewer := &domain.User{Role: domain.RoleViewer}
+	_, err := service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 50}, nil, viewer)
+	if !errors.Is(err, domain.ErrPageSizeTooLarge) {
+		t.Fatalf("viewer Limit 50 err = %v, want ErrPageSizeTooLarge", err)
+	}
+
+	admin := &domain.User{Role: domain.RoleAdmin}
+	if _, err := service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 200}, nil, admin); err != nil {
+		t.Fatalf("admin Limit 200: %v", err)
+	}
+}
+
+func TestListEmployeesAppliesConfiguredRoleLimits(t *testing.T) {
+	ctx := context.Background()
+	service := newEmployeeService(t)
+	seedDepartment(t, service, "engineering", 5)
+	service.SetPageSizeLimits(map[domain.UserRole]domain.PageSizeLimits{
+		domain.RoleViewer: {Default: 2, Max: 3},
+	})
+
+	viewer := &domain.User{Role: domain.RoleViewer}
+	result, err := service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{}, nil, viewer)
+	if err != nil {
+		t.Fatalf("ListEmployeesFields: %v", err)
+	}
+	if len(result.Employees) != 2 {
+		t.Fatalf("default page holds %d employees, want the configured default of 2", len(result.Employees))
+	}
+
+	_, err = service.ListEmployeesFields(ctx, domain.EmployeeFilter{}, domain.EmployeeSort{},
+		domain.Pagination{Limit: 4}, nil, viewer)
+	if !errors.Is(err, domain.ErrPageSizeTooLarge) {
+		t.Fatalf("Limit 4 err = %v, want ErrPageSizeTooLarge", err)
+	}
+}
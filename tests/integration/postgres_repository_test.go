@@ -0,0 +1,236 @@
+// Package integration exercises the concrete Postgres repositories against
+// a real database, started via testcontainers or supplied through
+// TEST_DATABASE_URL.
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/postgres"
+	"github.com/captain-corgi/vcd-claude-speckit/migrations"
+	"github.com/captain-corgi/vcd-claude-speckit/tests/helpers"
+)
+
+// setupDatabase returns a migrated database handle, preferring
+// TEST_DATABASE_URL and falling back to a throwaway testcontainers
+// instance. Tests skip when neither is available.
+func setupDatabase(t *testing.T) *sql.DB {
+	t.Helper()
+
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		ctx := context.Background()
+		container, err := tcpostgres.RunContainer(ctx,
+			testcontainers.WithImage("postgres:16-alpine"),
+			tcpostgres.WithDatabase("employees_test"),
+			tcpostgres.WithUsername("postgres"),
+			tcpostgres.WithPassword("postgres"),
+			testcontainers.WithWaitStrategy(
+				wait.ForLog("database system is ready to accept connections").
+					WithOccurrence(2).WithStartupTimeout(60*time.Second)),
+		)
+		if err != nil {
+			t.Skipf("docker is not available for testcontainers: %v", err)
+		}
+		t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+		databaseURL, err = container.ConnectionString(ctx, "sslmode=disable")
+		if err != nil {
+			t.Fatalf("failed to get connection string: %v", err)
+		}
+	}
+
+	runMigrations(t, databaseURL)
+	db, err := postgres.Open(databaseURL)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// runMigrations applies the embedded migrations to the test database.
+func runMigrations(t *testing.T, databaseURL string) {
+	t.Helper()
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		t.Fatalf("failed to open embedded migrations: %v", err)
+	}
+	m, err := migrate.NewWithSourceInstance("iofs", source, databaseURL)
+	if err != nil {
+		t.Fatalf("failed to create migrate instance: %v", err)
+	}
+	defer m.Close()
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		t.Fatalf("failed to run migrations: %v", err)
+	}
+}
+
+func TestEmployeeRepositoryCRUD(t *testing.T) {
+	db := setupDatabase(t)
+	repo := postgres.NewEmployeeRepository(db)
+	factory := helpers.NewEmployeeFactory(1)
+	ctx := context.Background()
+
+	employee := factory.Build(t)
+	if err := repo.Create(ctx, employee); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	fetched, err := repo.GetByID(ctx, employee.ID)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if fetched == nil || fetched.Email != employee.Email {
+		t.Fatalf("fetched employee mismatch: %+v", fetched)
+	}
+
+	fetched.Position = "Staff Engineer"
+	if err := repo.Update(ctx, fetched); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	updated, err := repo.GetByID(ctx, employee.ID)
+	if err != nil || updated == nil {
+		t.Fatalf("get after update failed: %v", err)
+	}
+	if updated.Position != "Staff Engineer" {
+		t.Fatalf("expected updated position, got %s", updated.Position)
+	}
+	if updated.Version != employee.Version+1 {
+		t.Fatalf("expected version bump, got %d", updated.Version)
+	}
+
+	if err := repo.Delete(ctx, employee.ID); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, employee.ID); !errors.Is(err, domain.ErrEmployeeNotFound) {
+		t.Fatalf("get after delete: err = %v, want ErrEmployeeNotFound", err)
+	}
+}
+
+func TestEmployeeRepositoryEmailUniqueness(t *testing.T) {
+	db := setupDatabase(t)
+	repo := postgres.NewEmployeeRepository(db)
+	factory := helpers.NewEmployeeFactory(2)
+	ctx := context.Background()
+
+	first := factory.Build(t, helpers.WithEmail("unique.check@example.com"))
+	if err := repo.Create(ctx, first); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	exists, err := repo.ExistsByEmail(ctx, first.Email)
+	if err != nil {
+		t.Fatalf("exists check failed: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected email to exist")
+	}
+
+	duplicate := factory.Build(t, helpers.WithEmail("unique.check@example.com"))
+	if err := repo.Create(ctx, duplicate); err == nil {
+		t.Fatal("expected unique-constraint violation on duplicate email")
+	}
+}
+
+func TestEmployeeRepositoryFilterAndPagination(t *testing.T) {
+	db := setupDatabase(t)
+	repo := postgres.NewEmployeeRepository(db)
+	factory := helpers.NewEmployeeFactory(3)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if err := repo.Create(ctx, factory.Build(t, helpers.WithDepartment("Pagination"))); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+
+	filter := domain.EmployeeFilter{Department: "Pagination"}
+	sort := domain.EmployeeSort{Field: "email", Direction: domain.SortAsc}
+
+	firstPage, err := repo.List(ctx, filter, sort, domain.Pagination{Limit: 2})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if firstPage.TotalCount != 5 || len(firstPage.Employees) != 2 {
+		t.Fatalf("unexpected first page: total=%d len=%d", firstPage.TotalCount, len(firstPage.Employees))
+	}
+	if !firstPage.PageInfo.HasNextPage {
+		t.Fatal("expected a next page")
+	}
+
+	secondPage, err := repo.List(ctx, filter, sort, domain.Pagination{Limit: 2, After: firstPage.PageInfo.EndCursor})
+	if err != nil {
+		t.Fatalf("second list failed: %v", err)
+	}
+	if len(secondPage.Employees) != 2 {
+		t.Fatalf("unexpected second page size: %d", len(secondPage.Employees))
+	}
+	// Cursor pagination must not repeat rows across pages.
+	seen := map[string]bool{}
+	for _, e := range append(firstPage.Employees, secondPage.Employees...) {
+		if seen[e.Email] {
+			t.Fatalf("employee %s appeared on two pages", e.Email)
+		}
+		seen[e.Email] = true
+	}
+}
+
+func TestUserRepositoryCRUDAndLookup(t *testing.T) {
+	db := setupDatabase(t)
+	repo := postgres.NewUserRepository(db)
+	factory := helpers.NewUserFactory(4)
+	ctx := context.Background()
+
+	user := factory.Build(t, helpers.WithRole(domain.RoleManager))
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	byName, err := repo.FindByUsername(ctx, user.Username)
+	if err != nil {
+		t.Fatalf("find failed: %v", err)
+	}
+	if byName == nil || byName.ID != user.ID {
+		t.Fatalf("lookup mismatch: %+v", byName)
+	}
+
+	exists, err := repo.ExistsByUsername(ctx, user.Username)
+	if err != nil || !exists {
+		t.Fatalf("expected username to exist: %v", err)
+	}
+
+	byName.IsActive = false
+	byName.UpdatedAt = time.Now()
+	if err := repo.Update(ctx, byName); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	active := false
+	result, err := repo.List(ctx, domain.UserFilter{Role: domain.RoleManager, IsActive: &active}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	found := false
+	for _, u := range result.Users {
+		if u.ID == user.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected deactivated manager in filtered list")
+	}
+}
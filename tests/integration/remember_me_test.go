@@ -0,0 +1,105 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// TestRememberMeExtendsRefreshTokenLifetime verifies rememberMe selects the
+// extended refresh TTL and marks the claims, while a plain login gets the
+// standard lifetime.
+func TestRememberMeExtendsRefreshTokenLifetime(t *testing.T) {
+	tokens := auth.NewTokenService("remember-me-secret")
+	user, err := domain.NewUser("remember_user", "remember_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	short, err := tokens.IssueRefreshToken(user, false)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken: %v", err)
+	}
+	long, err := tokens.IssueRefreshToken(user, true)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken with rememberMe: %v", err)
+	}
+
+	shortClaims, err := tokens.ValidateRefreshToken(short)
+	if err != nil {
+		t.Fatalf("ValidateRefreshToken: %v", err)
+	}
+	longClaims, err := tokens.ValidateRefreshToken(long)
+	if err != nil {
+		t.Fatalf("ValidateRefreshToken with rememberMe: %v", err)
+	}
+	if shortClaims.Extended {
+		t.Error("plain refresh token claims Extended = true, want false")
+	}
+	if !longClaims.Extended {
+		t.Error("rememberMe refresh token claims Extended = false, want true")
+	}
+	gap := longClaims.ExpiresAt.Sub(shortClaims.ExpiresAt.Time)
+	want := auth.ExtendedRefreshTokenTTL - auth.RefreshTokenTTL
+	if gap < want-time.Minute || gap > want+time.Minute {
+		t.Errorf("expiry gap = %v, want about %v", gap, want)
+	}
+}
+
+// TestRefreshTokenCannotAuthenticateRequests verifies the two token kinds
+// are not interchangeable: ValidateToken rejects refresh tokens and
+// ValidateRefreshToken rejects access tokens.
+func TestRefreshTokenCannotAuthenticateRequests(t *testing.T) {
+	tokens := auth.NewTokenService("remember-me-secret")
+	user, err := domain.NewUser("refresh_user", "refresh_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	refresh, err := tokens.IssueRefreshToken(user, false)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken: %v", err)
+	}
+	if _, err := tokens.ValidateToken(refresh); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Errorf("ValidateToken(refresh) = %v, want ErrInvalidToken", err)
+	}
+
+	access, err := tokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	if _, err := tokens.ValidateRefreshToken(access); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Errorf("ValidateRefreshToken(access) = %v, want ErrInvalidToken", err)
+	}
+	if _, err := tokens.ValidateToken(access); err != nil {
+		t.Errorf("ValidateToken(access) = %v, want nil", err)
+	}
+}
+
+// TestSetExtendedRefreshTokenTTLOverridesLifetime verifies the configured
+// extended lifetime is used for rememberMe tokens.
+func TestSetExtendedRefreshTokenTTLOverridesLifetime(t *testing.T) {
+	tokens := auth.NewTokenService("remember-me-secret")
+	tokens.SetRefreshTokenTTL(time.Hour)
+	tokens.SetExtendedRefreshTokenTTL(48 * time.Hour)
+	user, err := domain.NewUser("ttl_user", "ttl_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	long, err := tokens.IssueRefreshToken(user, true)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken: %v", err)
+	}
+	claims, err := tokens.ValidateRefreshToken(long)
+	if err != nil {
+		t.Fatalf("ValidateRefreshToken: %v", err)
+	}
+	ttl := claims.ExpiresAt.Sub(claims.IssuedAt.Time)
+	if ttl != 48*time.Hour {
+		t.Errorf("refresh token TTL = %v, want 48h", ttl)
+	}
+}
@@ -0,0 +1,47 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestStreamEmployeesVisitsEveryMatch(t *testing.T) {
+	repo := seedListEmployees(t, 7)
+	ctx := context.Background()
+
+	var seen []string
+	err := repo.StreamEmployees(ctx, domain.EmployeeFilter{}, func(e *domain.Employee) error {
+		seen = append(seen, e.Email)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamEmployees: %v", err)
+	}
+	if len(seen) != 7 {
+		t.Fatalf("streamed %d employees, want 7", len(seen))
+	}
+}
+
+func TestStreamEmployeesStopsOnCallbackError(t *testing.T) {
+	repo := seedListEmployees(t, 7)
+	ctx := context.Background()
+	stop := errors.New("stop")
+
+	visited := 0
+	err := repo.StreamEmployees(ctx, domain.EmployeeFilter{}, func(e *domain.Employee) error {
+		visited++
+		if visited == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("err = %v, want the callback error unchanged", err)
+	}
+	if visited != 3 {
+		t.Fatalf("visited %d employees after aborting, want 3", visited)
+	}
+}
@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	repo := memory.NewEmployeeRepository()
+	tenantA := uuid.New()
+	tenantB := uuid.New()
+	ctxA := domain.WithTenant(context.Background(), tenantA)
+	ctxB := domain.WithTenant(context.Background(), tenantB)
+
+	employee, err := domain.NewEmployee("Jane", "Doe", "jane.doe@example.com", "",
+		"Engineering", "Engineer", 90000, time.Now().AddDate(-1, 0, 0), nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	employee.TenantID = tenantA
+	if err := repo.Create(ctxA, employee); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// The owning tenant sees the employee.
+	got, err := repo.GetByID(ctxA, employee.ID)
+	if err != nil {
+		t.Fatalf("GetByID (tenant A): %v", err)
+	}
+	if got == nil {
+		t.Fatal("GetByID (tenant A): employee not visible to its own tenant")
+	}
+
+	// Another tenant does not, by ID or by listing.
+	if _, err := repo.GetByID(ctxB, employee.ID); !errors.Is(err, domain.ErrEmployeeNotFound) {
+		t.Fatalf("GetByID (tenant B): err = %v, want ErrEmployeeNotFound", err)
+	}
+	result, err := repo.List(ctxB, domain.EmployeeFilter{}, domain.EmployeeSort{}, domain.Pagination{Limit: 10})
+	if err != nil {
+		t.Fatalf("List (tenant B): %v", err)
+	}
+	if result.TotalCount != 0 {
+		t.Fatalf("List (tenant B): TotalCount = %d, want 0", result.TotalCount)
+	}
+
+	// The system-level flag crosses tenants explicitly.
+	got, err = repo.GetByID(domain.WithCrossTenantAccess(ctxB), employee.ID)
+	if err != nil {
+		t.Fatalf("GetByID (cross-tenant): %v", err)
+	}
+	if got == nil {
+		t.Fatal("GetByID (cross-tenant): employee not visible with cross-tenant access")
+	}
+}
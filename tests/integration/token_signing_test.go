@@ -0,0 +1,116 @@
+package integration
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/auth"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+// rsaPrivateKeyPEM generates a PEM-encoded RSA private key for tests.
+func rsaPrivateKeyPEM(t *testing.T) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+}
+
+// TestRS256TokenRoundTrip verifies tokens signed with an RSA key validate
+// and carry the expected claims.
+func TestRS256TokenRoundTrip(t *testing.T) {
+	tokens, err := auth.NewTokenServiceWithKey("RS256", rsaPrivateKeyPEM(t), "key-1")
+	if err != nil {
+		t.Fatalf("NewTokenServiceWithKey: %v", err)
+	}
+	user, err := domain.NewUser("rs256_user", "rs256_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	signed, err := tokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	claims, err := tokens.ValidateToken(signed)
+	if err != nil {
+		t.Fatalf("ValidateToken: %v", err)
+	}
+	if claims.Username != "rs256_user" {
+		t.Errorf("claims.Username = %q, want %q", claims.Username, "rs256_user")
+	}
+	if claims.UserID != user.ID {
+		t.Errorf("claims.UserID = %v, want %v", claims.UserID, user.ID)
+	}
+}
+
+// TestRotateSigningKeyKeepsOldTokensValid verifies rotation signs new
+// tokens with the new key while tokens signed by the previous key keep
+// validating, and that the JWKS advertises both public keys.
+func TestRotateSigningKeyKeepsOldTokensValid(t *testing.T) {
+	tokens, err := auth.NewTokenServiceWithKey("RS256", rsaPrivateKeyPEM(t), "key-1")
+	if err != nil {
+		t.Fatalf("NewTokenServiceWithKey: %v", err)
+	}
+	user, err := domain.NewUser("rotate_user", "rotate_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	oldToken, err := tokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	if err := tokens.RotateSigningKey(rsaPrivateKeyPEM(t), "key-2"); err != nil {
+		t.Fatalf("RotateSigningKey: %v", err)
+	}
+
+	if _, err := tokens.ValidateToken(oldToken); err != nil {
+		t.Errorf("token signed before rotation no longer validates: %v", err)
+	}
+	newToken, err := tokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("IssueToken after rotation: %v", err)
+	}
+	if _, err := tokens.ValidateToken(newToken); err != nil {
+		t.Errorf("token signed after rotation does not validate: %v", err)
+	}
+
+	jwks := tokens.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("JWKS has %d keys, want 2", len(jwks.Keys))
+	}
+	if jwks.Keys[0].Kid != "key-1" || jwks.Keys[1].Kid != "key-2" {
+		t.Errorf("JWKS kids = %q, %q, want key-1, key-2", jwks.Keys[0].Kid, jwks.Keys[1].Kid)
+	}
+}
+
+// TestTokenServiceRejectsCrossAlgorithmTokens verifies an HS256 token is
+// rejected by an RS256-configured service.
+func TestTokenServiceRejectsCrossAlgorithmTokens(t *testing.T) {
+	rsaTokens, err := auth.NewTokenServiceWithKey("RS256", rsaPrivateKeyPEM(t), "key-1")
+	if err != nil {
+		t.Fatalf("NewTokenServiceWithKey: %v", err)
+	}
+	hmacTokens := auth.NewTokenService("some-shared-secret")
+	user, err := domain.NewUser("alg_user", "alg_user@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	signed, err := hmacTokens.IssueToken(user)
+	if err != nil {
+		t.Fatalf("IssueToken: %v", err)
+	}
+	if _, err := rsaTokens.ValidateToken(signed); err == nil {
+		t.Fatal("expected an HS256 token to be rejected by the RS256 service")
+	}
+}
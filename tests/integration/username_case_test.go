@@ -0,0 +1,69 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+	"github.com/captain-corgi/vcd-claude-speckit/internal/repository/memory"
+)
+
+func TestFindByUsernameIsCaseInsensitive(t *testing.T) {
+	repo := memory.NewUserRepository()
+	ctx := context.Background()
+
+	user, err := domain.NewUser("admin", "admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	found, err := repo.FindByUsername(ctx, "Admin")
+	if err != nil {
+		t.Fatalf("FindByUsername: %v", err)
+	}
+	if found == nil {
+		t.Fatal("FindByUsername(\"Admin\") did not find the user registered as \"admin\"")
+	}
+	if found.Username != "admin" {
+		t.Fatalf("found username %q, want the stored display case %q", found.Username, "admin")
+	}
+}
+
+func TestCreateRejectsUsernameCaseVariant(t *testing.T) {
+	repo := memory.NewUserRepository()
+	ctx := context.Background()
+
+	user, err := domain.NewUser("admin", "admin@example.com", "Str0ng-Passw0rd!", domain.RoleAdmin)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if err := repo.Create(ctx, user); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	exists, err := repo.ExistsByUsername(ctx, "ADMIN")
+	if err != nil {
+		t.Fatalf("ExistsByUsername: %v", err)
+	}
+	if !exists {
+		t.Fatal("ExistsByUsername(\"ADMIN\") = false, want true for existing \"admin\"")
+	}
+
+	variant, err := domain.NewUser("Admin", "admin2@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if err := repo.Create(ctx, variant); !errors.Is(err, domain.ErrUsernameAlreadyExists) {
+		t.Fatalf("Create(\"Admin\") err = %v, want ErrUsernameAlreadyExists", err)
+	}
+}
+
+func TestNormalizeUsername(t *testing.T) {
+	if got := domain.NormalizeUsername("  Admin "); got != "admin" {
+		t.Fatalf("NormalizeUsername = %q, want %q", got, "admin")
+	}
+}
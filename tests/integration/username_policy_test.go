@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestUsernamePolicyReservedNames(t *testing.T) {
+	policy := domain.DefaultUsernamePolicy()
+	policy.SetReservedNames([]string{"admin", "root", "system"})
+	domain.SetUsernamePolicy(policy)
+	defer domain.SetUsernamePolicy(nil)
+
+	_, err := domain.NewUser("Admin", "admin@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if !errors.Is(err, domain.ErrReservedUsername) {
+		t.Fatalf("NewUser(\"Admin\") err = %v, want ErrReservedUsername", err)
+	}
+	if _, err := domain.NewUser("alice", "alice@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err != nil {
+		t.Fatalf("NewUser(\"alice\"): %v", err)
+	}
+}
+
+func TestUsernamePolicyEmailAndSeparators(t *testing.T) {
+	policy := domain.DefaultUsernamePolicy()
+	policy.AllowEmail = true
+	policy.AllowDots = true
+	domain.SetUsernamePolicy(policy)
+	defer domain.SetUsernamePolicy(nil)
+
+	if _, err := domain.NewUser("alice@example.com", "alice@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err != nil {
+		t.Fatalf("email username: %v", err)
+	}
+	if _, err := domain.NewUser("alice.smith", "asmith@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err != nil {
+		t.Fatalf("dotted username: %v", err)
+	}
+	if _, err := domain.NewUser("alice-smith", "asmith2@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err == nil {
+		t.Fatal("hyphenated username accepted without AllowHyphens")
+	}
+}
+
+func TestUsernamePolicyDefaultRejectsSeparators(t *testing.T) {
+	if _, err := domain.NewUser("alice.smith", "asmith@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err == nil {
+		t.Fatal("dotted username accepted under the default policy")
+	}
+	if _, err := domain.NewUser("_alice", "alice@example.com", "Str0ng-Passw0rd!", domain.RoleViewer); err == nil {
+		t.Fatal("leading-underscore username accepted under the default policy")
+	}
+}
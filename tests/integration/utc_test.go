@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/captain-corgi/vcd-claude-speckit/internal/domain"
+)
+
+func TestEntityTimestampsAreUTC(t *testing.T) {
+	// A hire date supplied in a non-UTC zone must be stored normalized.
+	eastern := time.FixedZone("UTC-5", -5*60*60)
+	hireDate := time.Date(2024, 3, 1, 9, 0, 0, 0, eastern)
+
+	employee, err := domain.NewEmployee("Jane", "Doe", "jane.doe@example.com", "",
+		"Engineering", "Engineer", 90000, hireDate, nil)
+	if err != nil {
+		t.Fatalf("NewEmployee: %v", err)
+	}
+	for name, ts := range map[string]time.Time{
+		"HireDate":  employee.HireDate,
+		"CreatedAt": employee.CreatedAt,
+		"UpdatedAt": employee.UpdatedAt,
+	} {
+		if ts.Location() != time.UTC {
+			t.Errorf("employee %s location = %v, want UTC", name, ts.Location())
+		}
+	}
+	if !employee.HireDate.Equal(hireDate) {
+		t.Errorf("HireDate = %v, want same instant as %v", employee.HireDate, hireDate)
+	}
+
+	user, err := domain.NewUser("jdoe", "jane.doe@example.com", "Str0ng-Passw0rd!", domain.RoleViewer)
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	if user.CreatedAt.Location() != time.UTC || user.UpdatedAt.Location() != time.UTC {
+		t.Errorf("user timestamps not UTC: created %v, updated %v", user.CreatedAt.Location(), user.UpdatedAt.Location())
+	}
+
+	actor := uuid.New()
+	log, err := domain.NewAuditLog(&actor, nil, domain.OperationLogin, nil, nil, "127.0.0.1", "test")
+	if err != nil {
+		t.Fatalf("NewAuditLog: %v", err)
+	}
+	if log.Timestamp.Location() != time.UTC {
+		t.Errorf("audit timestamp location = %v, want UTC", log.Timestamp.Location())
+	}
+}